@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	"github.com/spf13/cobra"
+)
+
+var (
+	autocommitEvery  time.Duration
+	autocommitKeep   int
+	autocommitDaemon bool
+	autocommitStop   bool
+)
+
+var autocommitCmd = &cobra.Command{
+	Use:   "autocommit [vm-id|alias]",
+	Short: "Commit a VM on a schedule, keeping the most recent commits",
+	Long: `Commit a VM every --every until stopped, for cheap point-in-time recovery
+during long experiments. If no VM ID or alias is provided, commits the current HEAD.
+
+After each commit, auto-commits older than the newest --keep are deleted.
+Commits with a tag (see 'vers tag' and 'vers repo tag') are never deleted.
+The commit IDs are recorded in ~/.vers/autocommit/<vm-id>.json, so retention
+carries over when autocommit is restarted.
+
+By default autocommit runs in the foreground until Ctrl+C. --daemon runs it
+in a background process that logs to ~/.vers/autocommit/<vm-id>.log; stop it
+with --stop. Only one autocommit runs per VM at a time. --daemon is not
+supported on Windows.
+
+Examples:
+  vers autocommit my-vm --every 15m --keep 10
+  vers autocommit my-vm --every 1h --daemon
+  vers autocommit my-vm --stop
+  vers run-commit <commit-id>      # restore from an auto-commit`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var target string
+		if len(args) > 0 {
+			target = args[0]
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		return handlers.HandleAutocommit(ctx, application, handlers.AutocommitReq{
+			Target: target,
+			Every:  autocommitEvery,
+			Keep:   autocommitKeep,
+			Daemon: autocommitDaemon,
+			Stop:   autocommitStop,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(autocommitCmd)
+	autocommitCmd.Flags().DurationVar(&autocommitEvery, "every", 15*time.Minute, "Time between commits (at least 1m)")
+	autocommitCmd.Flags().IntVar(&autocommitKeep, "keep", 10, "Auto-commits to keep; older ones are deleted (0 keeps all)")
+	autocommitCmd.Flags().BoolVar(&autocommitDaemon, "daemon", false, "Run in a background process")
+	autocommitCmd.Flags().BoolVar(&autocommitStop, "stop", false, "Stop the background process started with --daemon")
+	autocommitCmd.MarkFlagsMutuallyExclusive("daemon", "stop")
+}
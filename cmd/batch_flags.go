@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// batchFlags holds the flags shared by commands that act on several targets.
+type batchFlags struct {
+	parallel int
+	retries  int
+	format   string
+}
+
+// addBatchFlags registers --parallel, --retries and --format.
+func addBatchFlags(c *cobra.Command, f *batchFlags) {
+	c.Flags().IntVar(&f.parallel, "parallel", utils.DefaultBatchParallel, "Maximum number of targets to process concurrently")
+	c.Flags().IntVar(&f.retries, "retries", utils.DefaultBatchRetries, "Times to retry a target after a transient API error")
+	c.Flags().StringVar(&f.format, "format", "", "Summary format (json, yaml, csv, ndjson)")
+}
+
+func (f batchFlags) opts() handlers.BatchOpts {
+	return handlers.BatchOpts{
+		Parallel: f.parallel,
+		Retries:  f.retries,
+		Format:   pres.ParseFormat(false, f.format),
+	}
+}
@@ -41,14 +41,14 @@ Use --wait to block until new VMs are running.`,
 			Count:    branchCount,
 			Wait:     branchWait,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
 		format := pres.ParseFormat(false, branchFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(res)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, res)
 		default:
 			pres.RenderBranch(application, res)
 		}
@@ -62,6 +62,6 @@ func init() {
 	branchCmd.Flags().StringVarP(&alias, "alias", "n", "", "Alias for the new VM")
 	branchCmd.Flags().BoolP("checkout", "c", false, "Switch to the new VM after creation")
 	branchCmd.Flags().IntVar(&branchCount, "count", 1, "Number of branches to create")
-	branchCmd.Flags().StringVar(&branchFormat, "format", "", "Output format (json)")
+	branchCmd.Flags().StringVar(&branchFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	branchCmd.Flags().BoolVar(&branchWait, "wait", false, "Wait until new VMs are running")
 }
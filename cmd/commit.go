@@ -56,14 +56,14 @@ Use --format json for machine-readable output.`,
 		res, err := handlers.HandleCommitCreate(apiCtx, application, handlers.CommitCreateReq{
 			Target: target,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
 		format := pres.ParseFormat(false, commitFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(res)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, res)
 		default:
 			if res.UsedHEAD {
 				fmt.Printf("Using current HEAD VM: %s\n", res.VmID)
@@ -79,6 +79,7 @@ var (
 	commitListPublic bool
 	commitListQuiet  bool
 	commitListFormat string
+	commitListOpts   pres.ListOptions
 )
 
 var commitListCmd = &cobra.Command{
@@ -89,7 +90,8 @@ var commitListCmd = &cobra.Command{
 Use -q/--quiet to output just commit IDs (one per line), useful for scripting:
   vers commit delete $(vers commit list -q)   # delete all commits
 
-Use --format json for machine-readable output.`,
+Use --format json for machine-readable output.` + listHelp(`  vers commit list --filter name='nightly-*' --sort -created
+  vers commit list --format '{{.CommitID}} {{.Name}}'`),
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
@@ -102,7 +104,15 @@ Use --format json for machine-readable output.`,
 			return err
 		}
 
+		spec := pres.CommitListSpec()
+		if res.Commits, err = pres.ApplyListOptions(spec, res.Commits, commitListOpts); err != nil {
+			return err
+		}
+
 		format := pres.ParseFormat(commitListQuiet, commitListFormat)
+		if ok, err := pres.RenderList(application, spec, res.Commits, commitListOpts, format); ok {
+			return err
+		}
 		switch format {
 		case pres.FormatQuiet:
 			ids := make([]string, len(res.Commits))
@@ -110,8 +120,10 @@ Use --format json for machine-readable output.`,
 				ids[i] = c.CommitID
 			}
 			pres.PrintQuiet(ids)
-		case pres.FormatJSON:
-			pres.PrintJSON(res.Commits)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML:
+			pres.PrintStructured(format, res.Commits)
+		case pres.FormatTemplate:
+			return pres.RenderListTemplate(application, res.Commits, commitListFormat)
 		default:
 			pres.RenderCommitsList(application, res)
 		}
@@ -119,6 +131,8 @@ Use --format json for machine-readable output.`,
 	},
 }
 
+var commitDeleteBatch batchFlags
+
 var commitDeleteCmd = &cobra.Command{
 	Use:   "delete <commit-id>...",
 	Short: "Delete one or more commits",
@@ -127,27 +141,20 @@ var commitDeleteCmd = &cobra.Command{
 Examples:
   vers commit delete abc-123
   vers commit delete abc-123 def-456
-  vers commit delete $(vers commit list -q)   # delete all commits`,
+  vers commit delete $(vers commit list -q)   # delete all commits
+  vers commit delete --format json abc-123 def-456
+
+Commits are deleted concurrently (--parallel at a time), and transient API
+errors are retried up to --retries times.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APILong)
 		defer cancel()
 
-		var firstErr error
-		for _, id := range args {
-			err := handlers.HandleCommitDelete(apiCtx, application, handlers.CommitDeleteReq{
-				CommitID: id,
-			})
-			if err != nil {
-				fmt.Fprintf(cmd.ErrOrStderr(), "✗ Failed to delete commit %s: %v\n", id, err)
-				if firstErr == nil {
-					firstErr = err
-				}
-				continue
-			}
-			fmt.Printf("✓ Commit %s deleted\n", id)
-		}
-		return firstErr
+		return handlers.HandleCommitDeleteMany(apiCtx, application, handlers.CommitDeleteManyReq{
+			CommitIDs: args,
+			Batch:     commitDeleteBatch.opts(),
+		})
 	},
 }
 
@@ -173,8 +180,8 @@ Use --format json for machine-readable output.`,
 
 		format := pres.ParseFormat(false, commitHistoryFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(res.Parents)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, res.Parents)
 		default:
 			pres.RenderCommitParents(application, res)
 		}
@@ -195,7 +202,7 @@ var commitPublishCmd = &cobra.Command{
 			CommitID: args[0],
 			IsPublic: true,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Commit %s is now public\n", info.CommitID)
@@ -216,7 +223,7 @@ var commitUnpublishCmd = &cobra.Command{
 			CommitID: args[0],
 			IsPublic: false,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Commit %s is now private\n", info.CommitID)
@@ -227,16 +234,18 @@ var commitUnpublishCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(commitCmd)
 
-	commitCreateCmd.Flags().StringVar(&commitFormat, "format", "", "Output format (json)")
+	commitCreateCmd.Flags().StringVar(&commitFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	commitCmd.AddCommand(commitCreateCmd)
 
 	commitListCmd.Flags().BoolVar(&commitListPublic, "public", false, "List public commits instead of your own")
 	commitListCmd.Flags().BoolVarP(&commitListQuiet, "quiet", "q", false, "Only display commit IDs")
-	commitListCmd.Flags().StringVar(&commitListFormat, "format", "", "Output format (json)")
+	commitListCmd.Flags().StringVar(&commitListFormat, "format", "", "Output format (json, yaml, csv, ndjson, wide, or a Go template)")
+	addListFlags(commitListCmd, &commitListOpts, "id, name, public, created")
 	commitCmd.AddCommand(commitListCmd)
 	commitCmd.AddCommand(commitDeleteCmd)
+	addBatchFlags(commitDeleteCmd, &commitDeleteBatch)
 
-	commitHistoryCmd.Flags().StringVar(&commitHistoryFormat, "format", "", "Output format (json)")
+	commitHistoryCmd.Flags().StringVar(&commitHistoryFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	commitCmd.AddCommand(commitHistoryCmd)
 	commitCmd.AddCommand(commitPublishCmd)
 	commitCmd.AddCommand(commitUnpublishCmd)
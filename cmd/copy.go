@@ -21,7 +21,9 @@ Examples:
   vers copy vm-123 ./local-file.txt /remote/path/
   vers copy vm-123 /remote/path/file.txt ./local-file.txt
   vers copy ./local-file.txt /remote/path/  (uses HEAD VM)
-  vers copy -r ./local-dir/ /remote/path/  (recursive directory copy)`,
+  vers copy -r ./local-dir/ /remote/path/  (recursive directory copy)
+  vers copy --verify ./model.bin /data/    (check SHA-256 on both ends)
+  vers copy -r --checksum-file SHA256SUMS /data/ ./data/`,
 	Args: cobra.RangeArgs(2, 3),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Use custom timeout if specified, otherwise use default APIMedium
@@ -32,13 +34,24 @@ Examples:
 		apiCtx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 		recursive, _ := cmd.Flags().GetBool("recursive")
+		verify, _ := cmd.Flags().GetBool("verify")
+		verifyRetries, _ := cmd.Flags().GetInt("verify-retries")
+		checksumFile, _ := cmd.Flags().GetString("checksum-file")
 		var target, source, destination string
 		if len(args) == 2 {
 			source, destination = args[0], args[1]
 		} else {
 			target, source, destination = args[0], args[1], args[2]
 		}
-		view, err := handlers.HandleCopy(apiCtx, application, handlers.CopyReq{Target: target, Source: source, Destination: destination, Recursive: recursive})
+		view, err := handlers.HandleCopy(apiCtx, application, handlers.CopyReq{
+			Target:        target,
+			Source:        source,
+			Destination:   destination,
+			Recursive:     recursive,
+			Verify:        verify,
+			VerifyRetries: verifyRetries,
+			ChecksumFile:  checksumFile,
+		})
 		if err != nil {
 			return err
 		}
@@ -50,5 +63,8 @@ Examples:
 func init() {
 	rootCmd.AddCommand(copyCmd)
 	copyCmd.Flags().BoolP("recursive", "r", false, "Recursively copy directories")
+	copyCmd.Flags().Bool("verify", false, "Verify each file with SHA-256 on both ends after transfer")
+	copyCmd.Flags().Int("verify-retries", 2, "Re-transfer attempts for a file whose checksum does not match")
+	copyCmd.Flags().String("checksum-file", "", "Write a sha256sum-compatible manifest of transferred files")
 	copyCmd.Flags().IntVarP(&copyTimeout, "timeout", "t", 0, "Timeout in seconds (default: 30s, use 0 for no limit)")
 }
@@ -56,14 +56,14 @@ Examples:
 		}
 
 		view, err := handlers.HandleDeploy(apiCtx, application, req)
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
 		format := pres.ParseFormat(false, deployFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(view)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view)
 		default:
 			pres.RenderDeploy(application, view)
 		}
@@ -80,6 +80,6 @@ func init() {
 	deployCmd.Flags().StringVar(&deployBuildCommand, "build", "", "Build command (e.g. \"npm run build\")")
 	deployCmd.Flags().StringVar(&deployRunCommand, "run", "", "Run command (e.g. \"npm start\")")
 	deployCmd.Flags().StringVar(&deployWorkingDirectory, "working-dir", "", "Working directory relative to repo root")
-	deployCmd.Flags().StringVar(&deployFormat, "format", "", "Output format (json)")
+	deployCmd.Flags().StringVar(&deployFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	deployCmd.Flags().BoolVar(&deployWait, "wait", false, "Wait until the VM is running before returning")
 }
@@ -0,0 +1,29 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+// dryRunAnnotation marks commands that honour --dry-run.
+const dryRunAnnotation = "vers/dry-run"
+
+func init() {
+	// Every other command refuses --dry-run rather than silently running
+	supportsDryRun(
+		runCmd, runCommitCmd, branchCmd, killCmd, gcCmd, deployCmd, resizeCmd,
+		commitCmd, commitCreateCmd, commitDeleteCmd, commitPublishCmd, commitUnpublishCmd,
+		tagCreateCmd, tagUpdateCmd, tagDeleteCmd,
+		repoCreateCmd, repoDeleteCmd, repoVisibilityCmd, repoForkCmd,
+		repoTagCreateCmd, repoTagUpdateCmd, repoTagDeleteCmd,
+		envSetCmd, envDeleteCmd,
+	)
+}
+
+// supportsDryRun marks commands whose handlers print the API calls they
+// would make under --dry-run instead of making them.
+func supportsDryRun(cmds ...*cobra.Command) {
+	for _, c := range cmds {
+		if c.Annotations == nil {
+			c.Annotations = map[string]string{}
+		}
+		c.Annotations[dryRunAnnotation] = "true"
+	}
+}
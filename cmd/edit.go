@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+var (
+	editEditor string
+	editForce  bool
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit [vm-id|alias:]<path>",
+	Short: "Edit a file on a VM with your local editor",
+	Long: `Download a file from a VM, open it in your local editor, and upload it again when
+you save and quit. The file mode is preserved. If no VM ID or alias is given before
+the colon, uses the current HEAD.
+
+The editor is taken from --editor, $VISUAL or $EDITOR, falling back to vi.
+If the file was changed on the VM while you were editing, you are asked before
+overwriting it; declining keeps your edits in a local temp file.
+
+Examples:
+  vers edit my-vm:/etc/nginx/nginx.conf
+  vers edit /etc/hosts                       (uses HEAD VM)
+  vers edit --editor "code --wait" my-vm:/app/config.yaml`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var target, path string
+		if len(args) == 2 {
+			target, path = args[0], args[1]
+		} else {
+			target, path = parseRemoteSpec(args[0])
+		}
+
+		// No timeout: the editor stays open as long as the user needs
+		view, err := handlers.HandleEdit(context.Background(), application, handlers.EditReq{
+			Target: target,
+			Path:   path,
+			Editor: editEditor,
+			Force:  editForce,
+		})
+		if err != nil {
+			if view.LocalCopy != "" {
+				pres.RenderEdit(application, view)
+			}
+			return err
+		}
+		pres.RenderEdit(application, view)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+	editCmd.Flags().StringVar(&editEditor, "editor", "", "Editor command (default: $VISUAL, $EDITOR or vi)")
+	editCmd.Flags().BoolVarP(&editForce, "force", "f", false, "Overwrite remote changes made while editing without asking")
+}
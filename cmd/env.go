@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"sort"
 	"text/tabwriter"
 
 	"github.com/hdresearch/vers-cli/internal/handlers"
@@ -12,7 +11,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var envFormat string
+var (
+	envFormat   string
+	envListOpts pres.ListOptions
+)
 
 // envCmd represents the env command
 var envCmd = &cobra.Command{
@@ -32,7 +34,8 @@ var envListCmd = &cobra.Command{
 	Short: "List all environment variables",
 	Long: `List all environment variables configured for your account.
 
-These variables will be injected into newly created VMs at boot time.`,
+These variables will be injected into newly created VMs at boot time.` + listHelp(`  vers env list --filter key='AWS_*'
+  vers env list --format '{{.Key}}={{.Value}}'`),
 	Aliases: []string{"ls"},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
@@ -43,32 +46,41 @@ These variables will be injected into newly created VMs at boot time.`,
 			return err
 		}
 
+		// Sorted by key for consistent output
+		spec := pres.EnvListSpec()
+		items, err := pres.ApplyListOptions(spec, pres.EnvVars(vars), envListOpts)
+		if err != nil {
+			return err
+		}
+
 		format := pres.ParseFormat(false, envFormat)
+		if ok, err := pres.RenderList(application, spec, items, envListOpts, format); ok {
+			return err
+		}
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(vars)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML:
+			filtered := make(map[string]string, len(items))
+			for _, e := range items {
+				filtered[e.Key] = e.Value
+			}
+			pres.PrintStructured(format, filtered)
+		case pres.FormatTemplate:
+			return pres.RenderListTemplate(application, items, envFormat)
 		default:
-			if len(vars) == 0 {
+			if len(items) == 0 {
 				fmt.Println("No environment variables configured.")
 				return nil
 			}
 
-			// Sort keys for consistent output
-			keys := make([]string, 0, len(vars))
-			for k := range vars {
-				keys = append(keys, k)
-			}
-			sort.Strings(keys)
-
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			fmt.Fprintln(w, "KEY\tVALUE")
-			for _, key := range keys {
-				value := vars[key]
+			for _, e := range items {
+				value := e.Value
 				// Truncate long values for display
 				if len(value) > 50 {
 					value = value[:47] + "..."
 				}
-				fmt.Fprintf(w, "%s\t%s\n", key, value)
+				fmt.Fprintf(w, "%s\t%s\n", e.Key, value)
 			}
 			w.Flush()
 		}
@@ -110,7 +122,7 @@ Examples:
 			Key:   key,
 			Value: value,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
@@ -147,7 +159,7 @@ Examples:
 		err := handlers.HandleEnvDelete(apiCtx, application, handlers.EnvDeleteReq{
 			Key: key,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
@@ -188,5 +200,6 @@ func init() {
 	envCmd.AddCommand(envDeleteCmd)
 
 	// Add flags
-	envListCmd.Flags().StringVar(&envFormat, "format", "", "Output format (json)")
+	envListCmd.Flags().StringVar(&envFormat, "format", "", "Output format (json, yaml, csv, ndjson, wide, or a Go template)")
+	addListFlags(envListCmd, &envListOpts, "key, value")
 }
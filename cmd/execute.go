@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hdresearch/vers-cli/internal/handlers"
 	pres "github.com/hdresearch/vers-cli/internal/presenters"
 	"github.com/hdresearch/vers-cli/internal/utils"
+	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +20,23 @@ var executeTimeout int
 var executeSSH bool
 var executeWorkDir string
 var executeStdin bool
+var executeStreamStdin bool
+var executeTargets []string
+var executeAllChildren string
+var executeSelector string
+var executeParallel int
+var executeFormat string
+var executeMaxOutput int
+var executeDetach bool
+var executeTTY bool
+var executeScript string
+var executeEntrypoint string
+var executeEnvVars []string
+var executeEnvFiles []string
+var executePassEnv []string
+var executeTimestamps bool
+var executeTee string
+var executeSaveLogs bool
 
 // executeCmd represents the execute command
 var executeCmd = &cobra.Command{
@@ -35,8 +55,69 @@ This is useful for piping data into commands, e.g.:
 
   echo '{"jsonrpc":"2.0","method":"ping","id":1}' | vers exec -i <vm> my-server
 
-Use --ssh to bypass the API and connect directly via SSH (legacy behavior).`,
-	Args: cobra.MinimumNArgs(1),
+Stdin is read in full before the command starts. With --ssh or --tty it is
+streamed instead, as is --stream-stdin on a single VM, which uploads it over
+the API for large files and long-running producers. --stream-stdin needs an
+orchestrator that accepts streamed stdin; if it rejects the request before
+any stdin is sent, the command falls back to an SSH session.
+
+Use --ssh to bypass the API and connect directly via SSH (legacy behavior).
+
+Use --targets, --all-children or -l (a label selector, see 'vers label') to
+run the command on several VMs at once.
+Output lines are prefixed with each VM's alias or ID, a summary table is
+printed at the end, and the exit code is non-zero if any VM failed:
+
+  vers exec --targets web-1,web-2,web-3 -- make test
+  vers exec --all-children base-vm --parallel 4 -- ./run-shard.sh
+  vers exec -l env=staging -- systemctl restart app
+
+Use --format json to print a single JSON document with the captured
+stdout and stderr (capped by --max-output), exit code, duration, exec ID
+and whether the command timed out. Use --format ndjson to print each
+stream event as a JSON line as it arrives.
+
+If the connection drops while a command is running, the output stream is
+resumed from where it left off. Use 'vers exec attach' to reattach to an
+exec by its ID. To run a command called attach or history on the HEAD VM,
+put -- before it: vers exec -- history
+
+Use --detach to start a long-running command in the background and return
+immediately with a job ID. Manage it with 'vers jobs':
+
+  vers exec --detach -- ./train.sh
+  vers jobs logs -f <job-id>
+
+Use --tty to run the command in a pseudo-terminal over SSH, for programs
+such as REPLs, editors and sudo prompts. Combine it with -i to type into it;
+-it is accepted as shorthand for -i --tty (-t on its own is --timeout):
+
+  vers exec -it my-vm -- python3
+
+Use --script to run a local script without quoting it by hand. The script
+(or a directory with an --entrypoint, run.sh by default) is copied to a
+temp directory on the VM, run with the interpreter from its shebang line,
+and removed afterwards. Remaining arguments are passed to the script:
+
+  vers exec --script ./setup.sh -- --verbose
+  vers exec --script ./deploy --entrypoint install.sh my-vm
+
+Use -e KEY=VAL, --env-file and --pass-env KEY to set environment variables
+for the command. -e KEY without a value forwards the local variable, like
+--pass-env. Later sources win: env files, then --pass-env, then -e.
+
+  vers exec --env-file .env -e DEBUG=1 --pass-env AWS_PROFILE -- ./run.sh
+
+Use --timestamps to prefix each output line with the time it arrived,
+--tee to also write stdout and stderr to a file, and --save-logs to keep a
+log under .vers/logs/exec. List saved logs with 'vers exec history'. With
+several VMs, the --tee file gets every VM's prefixed output.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if executeScript != "" {
+			return nil
+		}
+		return cobra.MinimumNArgs(1)(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Use custom timeout if specified, otherwise use default APIMedium
 		timeout := application.Timeouts.APIMedium
@@ -44,17 +125,103 @@ Use --ssh to bypass the API and connect directly via SSH (legacy behavior).`,
 			timeout = time.Duration(executeTimeout) * time.Second
 		}
 		apiCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		if executeTTY && executeTimeout == 0 {
+			// Interactive sessions last as long as the user keeps them open
+			cancel()
+			apiCtx, cancel = context.WithCancel(context.Background())
+		}
 		defer cancel()
 
+		var timeoutSec uint64
+		if executeTimeout > 0 {
+			timeoutSec = uint64(executeTimeout)
+		}
+
+		env, err := buildExecEnv(executeEnvVars, executeEnvFiles, executePassEnv, os.LookupEnv)
+		if err != nil {
+			return err
+		}
+
+		req := handlers.ExecuteReq{
+			Command:    args,
+			Env:        env,
+			WorkingDir: executeWorkDir,
+			TimeoutSec: timeoutSec,
+			UseSSH:     executeSSH,
+			TTY:        executeTTY,
+
+			Script:           executeScript,
+			ScriptEntrypoint: executeEntrypoint,
+
+			Timestamps: executeTimestamps,
+			TeePath:    executeTee,
+			SaveLog:    executeSaveLogs,
+		}
+		multi := len(executeTargets) > 0 || executeAllChildren != "" || executeSelector != ""
+
+		// Pass stdin if -i flag is set. It is read up front unless a single
+		// VM gets it over SSH or asked for --stream-stdin; several VMs each
+		// need their own copy.
+		if executeStdin {
+			if !multi && (executeSSH || executeTTY || executeStreamStdin) {
+				req.StdinStream = os.Stdin
+			} else {
+				data, err := io.ReadAll(os.Stdin)
+				if err != nil {
+					return fmt.Errorf("failed to read stdin: %w", err)
+				}
+				req.Stdin = string(data)
+			}
+		}
+
+		format := pres.ParseFormat(false, executeFormat)
+		switch format {
+		case pres.FormatJSON, pres.FormatYAML, pres.FormatCSV:
+			req.Capture = true
+			req.MaxOutput = executeMaxOutput
+		case pres.FormatNDJSON:
+			req.OnEvent = func(e pres.ExecuteEvent) { pres.PrintJSONLine(e) }
+		}
+
+		if multi {
+			if cmd.Flags().Changed("format") {
+				return fmt.Errorf("--format is not supported with --targets, --all-children or -l")
+			}
+			if executeDetach || executeTTY {
+				return fmt.Errorf("--detach and --tty are not supported with --targets, --all-children or -l")
+			}
+			// A default format from -o or VERS_FORMAT doesn't apply: several
+			// VMs always print prefixed output and a summary
+			req.Capture, req.OnEvent = false, nil
+			// Each target gets the timeout on its own
+			view, err := handlers.HandleExecuteMany(context.Background(), application, handlers.ExecuteManyReq{
+				Targets:       executeTargets,
+				AllChildren:   executeAllChildren,
+				Selector:      executeSelector,
+				Parallel:      executeParallel,
+				Exec:          req,
+				TargetTimeout: timeout,
+			})
+			if err != nil {
+				return err
+			}
+			pres.RenderExecuteSummary(application, view)
+			if view.Failed() {
+				os.Exit(1)
+			}
+			return nil
+		}
+
 		// Determine if the first arg is a VM target or part of the command.
-		// If there's only one arg, it's the command (use HEAD VM).
+		// If there's only one arg, it's the command (use HEAD VM), unless a
+		// script is being run, in which case all args are optional.
 		// If there are multiple args, check if the first arg looks like a VM
 		// identifier (UUID or known alias). If so, treat it as the target;
 		// otherwise treat all args as the command and use HEAD.
 		var target string
 		var command []string
 
-		if len(args) == 1 {
+		if len(args) == 0 || (len(args) == 1 && executeScript == "") {
 			target = ""
 			command = args
 		} else if utils.LooksLikeVMTarget(args[0]) {
@@ -65,33 +232,33 @@ Use --ssh to bypass the API and connect directly via SSH (legacy behavior).`,
 			command = args
 		}
 
-		var timeoutSec uint64
-		if executeTimeout > 0 {
-			timeoutSec = uint64(executeTimeout)
-		}
+		req.Target = target
+		req.Command = command
 
-		// Read stdin if -i flag is set
-		var stdinData string
-		if executeStdin {
-			data, err := io.ReadAll(os.Stdin)
+		if executeDetach {
+			jobView, err := handlers.HandleJobStart(apiCtx, application, req)
 			if err != nil {
-				return fmt.Errorf("failed to read stdin: %w", err)
+				return err
+			}
+			if format.Structured() {
+				pres.PrintStructured(format, jobView.Job)
+			} else {
+				pres.RenderJobStart(application, jobView)
 			}
-			stdinData = string(data)
+			return nil
 		}
 
-		view, err := handlers.HandleExecute(apiCtx, application, handlers.ExecuteReq{
-			Target:     target,
-			Command:    command,
-			WorkingDir: executeWorkDir,
-			TimeoutSec: timeoutSec,
-			UseSSH:     executeSSH,
-			Stdin:      stdinData,
-		})
-		if err != nil {
+		view, err := handlers.HandleExecute(apiCtx, application, req)
+		if req.Capture {
+			pres.RenderExecuteResult(application, view, err, format)
+			if err != nil {
+				os.Exit(1)
+			}
+		} else if err != nil {
 			return err
+		} else if format == pres.FormatDefault {
+			pres.RenderExecute(application, view)
 		}
-		pres.RenderExecute(application, view)
 
 		// Exit with the command's exit code
 		if view.ExitCode != 0 {
@@ -101,6 +268,62 @@ Use --ssh to bypass the API and connect directly via SSH (legacy behavior).`,
 	},
 }
 
+// envKeyPattern matches valid environment variable names.
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// buildExecEnv merges the --env-file, --pass-env and -e flags into one map,
+// in that order of precedence (lowest first). It returns nil if no variables
+// were given.
+func buildExecEnv(pairs, files, pass []string, lookup func(string) (string, bool)) (map[string]string, error) {
+	env := map[string]string{}
+
+	if len(files) > 0 {
+		fileEnv, err := godotenv.Read(files...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read env file: %w", err)
+		}
+		for k, v := range fileEnv {
+			env[k] = v
+		}
+	}
+
+	passLocal := func(key string) error {
+		val, ok := lookup(key)
+		if !ok {
+			return fmt.Errorf("environment variable %s is not set locally", key)
+		}
+		env[key] = val
+		return nil
+	}
+	for _, key := range pass {
+		if err := passLocal(key); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, pair := range pairs {
+		key, val, hasVal := strings.Cut(pair, "=")
+		if !hasVal {
+			if err := passLocal(key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		env[key] = val
+	}
+
+	for key := range env {
+		if !envKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid environment variable name %q", key)
+		}
+	}
+
+	if len(env) == 0 {
+		return nil, nil
+	}
+	return env, nil
+}
+
 func init() {
 	rootCmd.AddCommand(executeCmd)
 	executeCmd.Flags().SetInterspersed(false) // stop flag parsing after first positional arg
@@ -108,4 +331,102 @@ func init() {
 	executeCmd.Flags().BoolVar(&executeSSH, "ssh", false, "Use direct SSH instead of the VERS API")
 	executeCmd.Flags().StringVarP(&executeWorkDir, "workdir", "w", "", "Working directory for the command")
 	executeCmd.Flags().BoolVarP(&executeStdin, "interactive", "i", false, "Pass stdin to the remote command")
+	executeCmd.Flags().BoolVar(&executeStreamStdin, "stream-stdin", false, "Upload -i stdin incrementally over the API (needs orchestrator support)")
+	executeCmd.Flags().StringSliceVar(&executeTargets, "targets", nil, "Run on several VMs (comma-separated IDs or aliases)")
+	executeCmd.Flags().StringVar(&executeAllChildren, "all-children", "", "Run on every VM branched from this VM")
+	executeCmd.Flags().StringVarP(&executeSelector, "selector", "l", "", "Run on every VM matching a label selector (e.g. env=staging)")
+	executeCmd.Flags().IntVar(&executeParallel, "parallel", 8, "Maximum number of VMs to run on concurrently")
+	executeCmd.Flags().StringVar(&executeFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+	executeCmd.Flags().BoolVar(&executeTTY, "tty", false, "Allocate a pseudo-terminal (implies SSH)")
+	executeCmd.Flags().StringVar(&executeScript, "script", "", "Run a local script file or directory on the VM")
+	executeCmd.Flags().StringVar(&executeEntrypoint, "entrypoint", "", "Script to run when --script is a directory (default: run.sh)")
+	executeCmd.Flags().StringArrayVarP(&executeEnvVars, "env", "e", nil, "Set an environment variable (KEY=VAL, or KEY to forward the local value)")
+	executeCmd.Flags().StringArrayVar(&executeEnvFiles, "env-file", nil, "Read environment variables from a .env file")
+	executeCmd.Flags().StringArrayVar(&executePassEnv, "pass-env", nil, "Forward a local environment variable")
+	executeCmd.Flags().BoolVar(&executeTimestamps, "timestamps", false, "Prefix each output line with a timestamp")
+	executeCmd.Flags().StringVar(&executeTee, "tee", "", "Also write stdout and stderr to this file")
+	executeCmd.Flags().BoolVar(&executeSaveLogs, "save-logs", false, "Save output to a log under .vers/logs/exec")
+	executeCmd.Flags().BoolVarP(&executeDetach, "detach", "d", false, "Run the command in the background and print a job ID")
+	executeCmd.Flags().IntVar(&executeMaxOutput, "max-output", 1<<20, "Maximum bytes of stdout and stderr to keep each with --format json (0 for no limit)")
+}
+
+// routeExecSubcommands keeps the `vers exec` subcommands (attach, history)
+// from capturing remote commands of the same name. A subcommand is only
+// routed when it directly follows exec; after an exec flag the word starts
+// the remote command, so "--" is inserted before it. `vers exec -- history`
+// runs history on the HEAD VM.
+func routeExecSubcommands(args []string) []string {
+	c, _, err := rootCmd.Find(args)
+	if err != nil || c.Parent() != executeCmd {
+		return args
+	}
+	start := len(args)
+	for i, arg := range args {
+		if arg == executeCmd.Name() || executeCmd.HasAlias(arg) {
+			start = i + 1
+			break
+		}
+	}
+	for i := start; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			return args
+		case !strings.HasPrefix(arg, "-"):
+			if i == start {
+				return args
+			}
+			out := append([]string{}, args[:i]...)
+			out = append(out, "--")
+			return append(out, args[i:]...)
+		case !strings.Contains(arg, "=") && execFlagTakesValue(arg):
+			i++
+		}
+	}
+	return args
+}
+
+// expandExecTTY rewrites the docker-style -it and -ti of `vers exec` into
+// -i --tty. -t alone stays --timeout, so `vers exec -t 60 ...` keeps working.
+// Only flags before the first positional argument are rewritten, since
+// everything after it belongs to the remote command.
+func expandExecTTY(args []string) []string {
+	c, _, err := rootCmd.Find(args)
+	if err != nil || c != executeCmd {
+		return args
+	}
+	out := make([]string, 0, len(args)+1)
+	inExec := false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case !inExec:
+			inExec = arg == executeCmd.Name() || executeCmd.HasAlias(arg)
+		case arg == "--" || !strings.HasPrefix(arg, "-"):
+			return append(out, args[i:]...)
+		case arg == "-it" || arg == "-ti":
+			out = append(out, "-i", "--tty")
+			continue
+		case !strings.Contains(arg, "=") && execFlagTakesValue(arg) && i+1 < len(args):
+			out = append(out, arg, args[i+1])
+			i++
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out
+}
+
+// execFlagTakesValue reports whether a lone `vers exec` flag such as "-w"
+// or "--workdir" consumes the next argument.
+func execFlagTakesValue(arg string) bool {
+	name, long := strings.CutPrefix(arg, "--")
+	if !long && len(arg) != 2 {
+		return false
+	}
+	f := executeCmd.Flags().Lookup(name)
+	if !long {
+		f = executeCmd.Flags().ShorthandLookup(arg[1:])
+	}
+	return f != nil && f.NoOptDefVal == ""
 }
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+var executeAttachCursor uint64
+var executeAttachFormat string
+
+// executeAttachCmd reattaches to a running or finished exec
+var executeAttachCmd = &cobra.Command{
+	Use:   "attach [vm-id|alias] <exec-id>",
+	Short: "Reattach to the output of an earlier exec",
+	Long: `Stream the output of an exec started earlier and exit with its exit code.
+If no VM is specified, the current HEAD VM is used.
+
+The exec ID is shown when a stream could not be resumed, and is included in
+the output of --format json and --format ndjson. Use --cursor to skip events
+that were already received.
+
+Examples:
+  vers exec attach 7d0c9e1a
+  vers exec attach my-vm 7d0c9e1a --cursor 120`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := handlers.ExecuteAttachReq{ExecID: args[0], Cursor: executeAttachCursor}
+		if len(args) == 2 {
+			req.Target = args[0]
+			req.ExecID = args[1]
+		}
+
+		format := pres.ParseFormat(false, executeAttachFormat)
+		if format == pres.FormatNDJSON {
+			req.OnEvent = func(e pres.ExecuteEvent) { pres.PrintJSONLine(e) }
+		}
+
+		// Attached execs may run for a long time; only the user interrupts us
+		view, err := handlers.HandleExecuteAttach(context.Background(), application, req)
+		if err != nil {
+			return err
+		}
+		if format == pres.FormatDefault {
+			pres.RenderExecute(application, view)
+		}
+
+		if view.ExitCode != 0 {
+			os.Exit(view.ExitCode)
+		}
+		return nil
+	},
+}
+
+func init() {
+	executeCmd.AddCommand(executeAttachCmd)
+	executeAttachCmd.Flags().Uint64Var(&executeAttachCursor, "cursor", 0, "Only stream events after this cursor")
+	executeAttachCmd.Flags().StringVar(&executeAttachFormat, "format", "", "Output format (ndjson)")
+}
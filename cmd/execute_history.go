@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+var executeHistoryLimit int
+var executeHistoryFormat string
+
+// executeHistoryCmd lists logs saved with vers exec --save-logs
+var executeHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List saved exec logs",
+	Long: `List the logs saved by 'vers exec --save-logs' in this directory, newest first,
+with the VM, command, exit code and duration of each run.
+
+Use --format json for machine-readable output.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		view, err := handlers.HandleExecuteHistory(application, executeHistoryLimit)
+		if err != nil {
+			return err
+		}
+
+		switch format := pres.ParseFormat(false, executeHistoryFormat); format {
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view.Logs)
+		default:
+			pres.RenderExecuteHistory(application, view)
+		}
+		return nil
+	},
+}
+
+func init() {
+	executeCmd.AddCommand(executeHistoryCmd)
+	executeHistoryCmd.Flags().IntVarP(&executeHistoryLimit, "limit", "n", 20, "Maximum number of entries to show (0 for all)")
+	executeHistoryCmd.Flags().StringVar(&executeHistoryFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/hdresearch/vers-cli/internal/utils"
@@ -220,3 +222,78 @@ func TestExecuteCommandWithoutHEAD(t *testing.T) {
 		t.Fatal("Expected error when no HEAD is set")
 	}
 }
+
+func TestBuildExecEnv(t *testing.T) {
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, ".env")
+	os.WriteFile(envFile, []byte("FROM_FILE=1\nOVERRIDE=file\nTOKEN=\"quoted value\"\n"), 0644)
+
+	local := map[string]string{"HOME_DIR": "/home/me", "OVERRIDE": "local"}
+	lookup := func(k string) (string, bool) {
+		v, ok := local[k]
+		return v, ok
+	}
+
+	env, err := buildExecEnv([]string{"A=1", "B=x=y", "HOME_DIR"}, []string{envFile}, []string{"OVERRIDE"}, lookup)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"A":         "1",
+		"B":         "x=y",
+		"HOME_DIR":  "/home/me",
+		"FROM_FILE": "1",
+		"OVERRIDE":  "local",
+		"TOKEN":     "quoted value",
+	}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("env = %v, want %v", env, want)
+	}
+
+	if env, err := buildExecEnv(nil, nil, nil, lookup); err != nil || env != nil {
+		t.Errorf("expected nil env without flags, got %v, %v", env, err)
+	}
+	if _, err := buildExecEnv(nil, nil, []string{"MISSING"}, lookup); err == nil {
+		t.Error("expected error for unset --pass-env variable")
+	}
+	if _, err := buildExecEnv([]string{"1BAD=x"}, nil, nil, lookup); err == nil {
+		t.Error("expected error for invalid variable name")
+	}
+}
+
+func TestRouteExecSubcommands(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"exec", "attach", "7d0c9e1a"}, []string{"exec", "attach", "7d0c9e1a"}},
+		{[]string{"exec", "history", "-n", "5"}, []string{"exec", "history", "-n", "5"}},
+		{[]string{"exec", "--", "history"}, []string{"exec", "--", "history"}},
+		{[]string{"exec", "--targets", "a,b", "history"}, []string{"exec", "--targets", "a,b", "--", "history"}},
+		{[]string{"exec", "-w", "/srv", "attach", "x"}, []string{"exec", "-w", "/srv", "--", "attach", "x"}},
+		{[]string{"exec", "my-vm", "history"}, []string{"exec", "my-vm", "history"}},
+	}
+	for _, tt := range tests {
+		if got := routeExecSubcommands(tt.args); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("routeExecSubcommands(%q) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestExpandExecTTY(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"exec", "-it", "my-vm", "--", "python3"}, []string{"exec", "-i", "--tty", "my-vm", "--", "python3"}},
+		{[]string{"exec", "-w", "/srv", "-ti", "my-vm", "bash"}, []string{"exec", "-w", "/srv", "-i", "--tty", "my-vm", "bash"}},
+		{[]string{"exec", "-t", "60", "my-vm", "--", "sleep", "5"}, []string{"exec", "-t", "60", "my-vm", "--", "sleep", "5"}},
+		{[]string{"exec", "my-vm", "grep", "-it", "x"}, []string{"exec", "my-vm", "grep", "-it", "x"}},
+		{[]string{"copy", "-it"}, []string{"copy", "-it"}},
+	}
+	for _, tt := range tests {
+		if got := expandExecTTY(tt.args); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("expandExecTTY(%q) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
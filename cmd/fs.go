@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lsLong       bool
+	lsFormat     string
+	statFormat   string
+	rmRecursive  bool
+	mkdirParents bool
+)
+
+// lsCmd lists files on a VM over SFTP.
+var lsCmd = &cobra.Command{
+	Use:   "ls [vm-id|alias] [path]",
+	Short: "List files on a VM",
+	Long: `List a directory on a VM over SFTP. If no VM ID or alias is provided, uses the current HEAD.
+The path defaults to the home directory of the VM user.
+
+Examples:
+  vers ls /etc
+  vers ls -l my-vm /var/log
+  vers ls --format json /workspace`,
+	Args: cobra.MaximumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, path := splitTargetPath(args, false)
+
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+
+		view, err := handlers.HandleLs(apiCtx, application, handlers.FSReq{Target: target, Path: path})
+		if err != nil {
+			return err
+		}
+
+		switch format := pres.ParseFormat(false, lsFormat); format {
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view.Entries)
+		default:
+			pres.RenderLs(application, view, lsLong)
+		}
+		return nil
+	},
+}
+
+// catCmd prints a remote file to stdout.
+var catCmd = &cobra.Command{
+	Use:   "cat [vm-id|alias] <path>",
+	Short: "Print a file from a VM",
+	Long: `Print the contents of a file on a VM to stdout. If no VM ID or alias is provided, uses the current HEAD.
+
+Examples:
+  vers cat /etc/os-release
+  vers cat my-vm /var/log/app.log | grep ERROR`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, path := splitTargetPath(args, true)
+
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+
+		return handlers.HandleCat(apiCtx, application, handlers.FSReq{Target: target, Path: path})
+	},
+}
+
+// statCmd shows file information for a remote path.
+var statCmd = &cobra.Command{
+	Use:   "stat [vm-id|alias] <path>",
+	Short: "Show file information on a VM",
+	Long: `Show the type, mode, size and modification time of a path on a VM.
+If no VM ID or alias is provided, uses the current HEAD.
+
+Use --format json for machine-readable output.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, path := splitTargetPath(args, true)
+
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+
+		view, err := handlers.HandleStat(apiCtx, application, handlers.FSReq{Target: target, Path: path})
+		if err != nil {
+			return err
+		}
+
+		switch format := pres.ParseFormat(false, statFormat); format {
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view.Entry)
+		default:
+			pres.RenderStat(application, view)
+		}
+		return nil
+	},
+}
+
+// rmCmd removes a file or directory on a VM.
+var rmCmd = &cobra.Command{
+	Use:   "rm [vm-id|alias] <path>",
+	Short: "Remove a file on a VM",
+	Long: `Remove a file or empty directory on a VM. Use -r to remove a directory and its contents.
+If no VM ID or alias is provided, uses the current HEAD.
+
+Examples:
+  vers rm /tmp/build.log
+  vers rm -r my-vm /tmp/cache`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, path := splitTargetPath(args, true)
+
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+
+		view, err := handlers.HandleRm(apiCtx, application, handlers.FSReq{Target: target, Path: path, Recursive: rmRecursive})
+		if err != nil {
+			return err
+		}
+		if view.UsedHEAD {
+			fmt.Printf("Using current HEAD VM: %s\n", view.HeadID)
+		}
+		fmt.Printf("✓ Removed %s\n", view.Path)
+		return nil
+	},
+}
+
+// mkdirCmd creates a directory on a VM.
+var mkdirCmd = &cobra.Command{
+	Use:   "mkdir [vm-id|alias] <path>",
+	Short: "Create a directory on a VM",
+	Long: `Create a directory on a VM. Use -p to create missing parent directories.
+If no VM ID or alias is provided, uses the current HEAD.
+
+Examples:
+  vers mkdir /workspace
+  vers mkdir -p my-vm /data/models/v2`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, path := splitTargetPath(args, true)
+
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+
+		view, err := handlers.HandleMkdir(apiCtx, application, handlers.FSReq{Target: target, Path: path, Parents: mkdirParents})
+		if err != nil {
+			return err
+		}
+		if view.UsedHEAD {
+			fmt.Printf("Using current HEAD VM: %s\n", view.HeadID)
+		}
+		fmt.Printf("✓ Created %s\n", view.Path)
+		return nil
+	},
+}
+
+// splitTargetPath splits [vm-id|alias] <path> arguments. With two args the
+// first is the target. With a single arg it is the path, unless the path is
+// optional and the arg looks like a VM identifier rather than a path.
+func splitTargetPath(args []string, pathRequired bool) (target, path string) {
+	switch len(args) {
+	case 0:
+		return "", ""
+	case 1:
+		if !pathRequired && !strings.Contains(args[0], "/") && utils.LooksLikeVMTarget(args[0]) {
+			return args[0], ""
+		}
+		return "", args[0]
+	default:
+		return args[0], args[1]
+	}
+}
+
+// parseRemoteSpec splits a "[target:]path" spec into its parts. A spec
+// starting with "/" is always a path on the HEAD VM.
+func parseRemoteSpec(spec string) (target, remotePath string) {
+	if i := strings.Index(spec, ":"); i > 0 && !strings.HasPrefix(spec, "/") {
+		return spec[:i], spec[i+1:]
+	}
+	return "", spec
+}
+
+func init() {
+	rootCmd.AddCommand(lsCmd)
+	lsCmd.Flags().BoolVarP(&lsLong, "long", "l", false, "Show modes, sizes and modification times")
+	lsCmd.Flags().StringVar(&lsFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+
+	rootCmd.AddCommand(catCmd)
+
+	rootCmd.AddCommand(statCmd)
+	statCmd.Flags().StringVar(&statFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+
+	rootCmd.AddCommand(rmCmd)
+	rmCmd.Flags().BoolVarP(&rmRecursive, "recursive", "r", false, "Remove directories and their contents")
+
+	rootCmd.AddCommand(mkdirCmd)
+	mkdirCmd.Flags().BoolVarP(&mkdirParents, "parents", "p", false, "Create parent directories as needed")
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import "testing"
+
+func TestSplitTargetPath(t *testing.T) {
+	const vmID = "0b5c1a2e-1f3d-4c6b-9a8e-7d6c5b4a3f21"
+	tests := []struct {
+		name         string
+		args         []string
+		pathRequired bool
+		wantTarget   string
+		wantPath     string
+	}{
+		{"no args", nil, false, "", ""},
+		{"path only", []string{"/etc"}, false, "", "/etc"},
+		{"vm only", []string{vmID}, false, vmID, ""},
+		{"vm with required path", []string{vmID}, true, "", vmID},
+		{"relative path", []string{"logs"}, false, "", "logs"},
+		{"target and path", []string{"my-vm", "/var/log"}, true, "my-vm", "/var/log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, path := splitTargetPath(tt.args, tt.pathRequired)
+			if target != tt.wantTarget || path != tt.wantPath {
+				t.Errorf("splitTargetPath(%v, %v) = (%q, %q), want (%q, %q)",
+					tt.args, tt.pathRequired, target, path, tt.wantTarget, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestParseRemoteSpec(t *testing.T) {
+	tests := []struct {
+		spec       string
+		wantTarget string
+		wantPath   string
+	}{
+		{"my-vm:/workspace", "my-vm", "/workspace"},
+		{"my-vm:", "my-vm", ""},
+		{"/workspace", "", "/workspace"},
+		{"/data/a:b", "", "/data/a:b"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		target, path := parseRemoteSpec(tt.spec)
+		if target != tt.wantTarget || path != tt.wantPath {
+			t.Errorf("parseRemoteSpec(%q) = (%q, %q), want (%q, %q)", tt.spec, target, path, tt.wantTarget, tt.wantPath)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	"github.com/spf13/cobra"
+)
+
+var (
+	gcOlderThan      time.Duration
+	gcStates         []string
+	gcExcludeLabels  []string
+	gcAction         string
+	gcIncludeHead    bool
+	gcIncludeAliased bool
+	gcYes            bool
+	gcBatch          batchFlags
+)
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Pause or delete VMs that have been left running",
+	Long: `Find VMs created more than --older-than ago and pause or delete them.
+
+The plan is printed first and you are asked before anything happens. HEAD,
+aliased and protected VMs are skipped, as are VMs matching --exclude-label;
+--include-head and --include-aliased act on them too. Protected VMs (see
+'vers protect') are always skipped.
+
+Actions:
+  pause            Pause the VMs (default)
+  kill             Delete the VMs
+  commit-and-kill  Commit each VM, then delete it; a VM whose commit fails is kept
+
+Examples:
+  vers gc                                         # pause VMs older than a day
+  vers gc --older-than 6h --state running --action kill
+  vers gc --exclude-label keep=true --action commit-and-kill
+  vers gc --dry-run --action kill
+
+From cron, pass --yes and use --format json for a report. Without --yes,
+structured formats only print the plan:
+  vers gc --older-than 24h --action commit-and-kill --yes --format json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := handlers.HandleGC(context.Background(), application, handlers.GCReq{
+			OlderThan:      gcOlderThan,
+			States:         gcStates,
+			ExcludeLabels:  gcExcludeLabels,
+			Action:         gcAction,
+			IncludeHead:    gcIncludeHead,
+			IncludeAliased: gcIncludeAliased,
+			Yes:            gcYes,
+			Batch:          gcBatch.opts(),
+		})
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(gcCmd)
+	gcCmd.Flags().DurationVar(&gcOlderThan, "older-than", 24*time.Hour, "Only act on VMs created longer ago than this")
+	gcCmd.Flags().StringSliceVar(&gcStates, "state", []string{"running", "paused"}, "VM states to consider")
+	gcCmd.Flags().StringArrayVar(&gcExcludeLabels, "exclude-label", nil, "Skip VMs matching a label selector (repeatable)")
+	gcCmd.Flags().StringVar(&gcAction, "action", handlers.GCActionPause, "What to do: pause, kill or commit-and-kill")
+	gcCmd.Flags().BoolVar(&gcIncludeHead, "include-head", false, "Also act on the HEAD VM")
+	gcCmd.Flags().BoolVar(&gcIncludeAliased, "include-aliased", false, "Also act on VMs with an alias")
+	gcCmd.Flags().BoolVarP(&gcYes, "yes", "y", false, "Act without asking")
+	addBatchFlags(gcCmd, &gcBatch)
+}
@@ -40,8 +40,8 @@ Use --format json for machine-readable output.`,
 		switch format {
 		case pres.FormatQuiet:
 			pres.PrintQuiet([]string{res.Metadata.VmID})
-		case pres.FormatJSON:
-			pres.PrintJSON(res.Metadata)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, res.Metadata)
 		default:
 			pres.RenderInfo(application, res)
 		}
@@ -52,5 +52,5 @@ Use --format json for machine-readable output.`,
 func init() {
 	rootCmd.AddCommand(infoCmd)
 	infoCmd.Flags().BoolVarP(&infoQuiet, "quiet", "q", false, "Only display VM ID")
-	infoCmd.Flags().StringVar(&infoFormat, "format", "", "Output format (json)")
+	infoCmd.Flags().StringVar(&infoFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 }
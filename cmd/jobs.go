@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Manage detached background jobs",
+	Long: `List, follow, wait for and kill commands started with 'vers exec --detach'.
+
+Job metadata is kept in .vers/jobs in the current directory. Each job's output
+is written to a log file on the VM under /tmp/vers-jobs.`,
+}
+
+var jobsListFormat string
+
+var jobsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List detached jobs and their state",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+
+		view, err := handlers.HandleJobList(apiCtx, application)
+		if err != nil {
+			return err
+		}
+
+		switch format := pres.ParseFormat(false, jobsListFormat); format {
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view.Jobs)
+		default:
+			pres.RenderJobList(application, view)
+		}
+		return nil
+	},
+}
+
+var jobsLogsFollow bool
+
+var jobsLogsCmd = &cobra.Command{
+	Use:   "logs <job-id>",
+	Short: "Print the output of a job",
+	Long: `Print the combined stdout and stderr of a detached job.
+Use -f to keep streaming new output until the job exits.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		if !jobsLogsFollow {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, application.Timeouts.APIMedium)
+			defer cancel()
+		}
+		return handlers.HandleJobLogs(ctx, application, handlers.JobLogsReq{ID: args[0], Follow: jobsLogsFollow})
+	},
+}
+
+var jobsWaitCmd = &cobra.Command{
+	Use:   "wait <job-id>",
+	Short: "Wait for a job to finish",
+	Long:  `Block until a detached job exits, then exit with the job's exit code.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		view, err := handlers.HandleJobWait(context.Background(), application, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Job %s %s (exit code %d)\n", view.ID, view.State, view.ExitCode)
+		if view.ExitCode != 0 {
+			os.Exit(view.ExitCode)
+		}
+		return nil
+	},
+}
+
+var jobsKillSignal string
+
+var jobsKillCmd = &cobra.Command{
+	Use:   "kill <job-id>",
+	Short: "Stop a running job",
+	Long:  `Send a signal (TERM by default) to a detached job and all processes it started.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+
+		job, err := handlers.HandleJobKill(apiCtx, application, handlers.JobKillReq{ID: args[0], Signal: jobsKillSignal})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ Sent %s to job %s\n", jobsKillSignal, job.ID)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(jobsCmd)
+
+	jobsCmd.AddCommand(jobsListCmd)
+	jobsListCmd.Flags().StringVar(&jobsListFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+
+	jobsCmd.AddCommand(jobsLogsCmd)
+	jobsLogsCmd.Flags().BoolVarP(&jobsLogsFollow, "follow", "f", false, "Stream new output until the job exits")
+
+	jobsCmd.AddCommand(jobsWaitCmd)
+
+	jobsCmd.AddCommand(jobsKillCmd)
+	jobsKillCmd.Flags().StringVarP(&jobsKillSignal, "signal", "s", "TERM", "Signal to send (e.g. TERM, INT, KILL)")
+}
@@ -2,12 +2,22 @@ package cmd
 
 import (
 	"context"
+	"time"
 
 	"github.com/hdresearch/vers-cli/internal/handlers"
 	"github.com/spf13/cobra"
 )
 
-var skipConfirmation bool
+var (
+	skipConfirmation bool
+	killSelector     string
+	killBatch        batchFlags
+	killCommit       bool
+	killTag          string
+	killGraceful     string
+	killGraceTimeout time.Duration
+	killForce        bool
+)
 
 var killCmd = &cobra.Command{
 	Use:     "delete [vm-id]...",
@@ -20,13 +30,39 @@ Examples:
   vers delete vm-123abc                    # Delete single VM by ID
   vers delete vm-1 vm-2 vm-3               # Delete multiple VMs
   vers kill $(vers status -q)              # Delete all VMs
-  vers delete -y vm-123abc                 # Skip confirmation`,
+  vers kill -l env=staging                 # Delete VMs labelled env=staging
+  vers delete -y vm-123abc                 # Skip confirmation
+  vers kill -y --parallel 16 $(vers status -q)
+  vers kill -y --format json vm-1 vm-2     # Per-VM results as JSON
+  vers kill --commit vm-123abc             # Snapshot before deleting
+  vers kill --tag 'last-{alias}' web db    # Snapshot and tag each VM
+  vers kill --graceful 'systemctl stop app' --grace-timeout 10s --commit web
+
+VMs are deleted concurrently (--parallel at a time). Rate limiting, 5xx
+responses and dropped connections are retried up to --retries times.
+
+--commit takes a commit of each VM first and prints its ID, so a mistaken
+delete can be undone with 'vers run-commit <commit-id>'. A VM whose commit
+fails is not deleted. --tag also names the commit ({alias} and {id} are
+replaced per VM; an existing tag is moved) and implies --commit. --graceful
+runs a shell command on each VM before the commit, e.g. to flush state; if
+it fails or exceeds --grace-timeout, a warning is printed and the delete
+goes ahead.
+
+VMs locked with 'vers protect' are refused. --force deletes them after you
+type each one's ID, even with -y.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APILong)
-		defer cancel()
-		return handlers.HandleKill(ctx, application, handlers.KillReq{
+		// Each VM gets its own deadline in HandleKill, sized for --graceful
+		return handlers.HandleKill(context.Background(), application, handlers.KillReq{
 			Targets:          args,
+			Selector:         killSelector,
 			SkipConfirmation: skipConfirmation,
+			Batch:            killBatch.opts(),
+			Commit:           killCommit,
+			Tag:              killTag,
+			Graceful:         killGraceful,
+			GraceTimeout:     killGraceTimeout,
+			Force:            killForce,
 		})
 	},
 }
@@ -34,4 +70,11 @@ Examples:
 func init() {
 	rootCmd.AddCommand(killCmd)
 	killCmd.Flags().BoolVarP(&skipConfirmation, "yes", "y", false, "Skip confirmation prompts")
+	killCmd.Flags().StringVarP(&killSelector, "selector", "l", "", "Delete VMs matching a label selector (e.g. env=staging)")
+	killCmd.Flags().BoolVar(&killCommit, "commit", false, "Commit each VM before deleting it")
+	killCmd.Flags().StringVar(&killTag, "tag", "", "Tag the safety commit ({alias} and {id} are replaced per VM); implies --commit")
+	killCmd.Flags().StringVar(&killGraceful, "graceful", "", "Shell command to run on each VM before deleting it")
+	killCmd.Flags().DurationVar(&killGraceTimeout, "grace-timeout", 30*time.Second, "Time limit for the --graceful command")
+	killCmd.Flags().BoolVar(&killForce, "force", false, "Allow deleting protected VMs (asks for each VM's ID)")
+	addBatchFlags(killCmd, &killBatch)
 }
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var labelFormat string
+
+var labelCmd = &cobra.Command{
+	Use:   "label [vm-id|alias] [key=value|key-]...",
+	Short: "Set, remove or show local labels on a VM",
+	Long: `Attach key=value labels to a VM. Labels are stored locally in ~/.vers/labels.json
+next to your aliases and can be used to select VMs with -l on status, kill, exec
+and pause. If no VM ID or alias is provided, uses the current HEAD.
+
+Use key- to remove a label. With no labels given, shows the VM's labels.
+The first argument is taken as the VM only if it isn't a label argument.
+
+Examples:
+  vers label my-vm env=staging team=ml
+  vers label my-vm team-
+  vers label my-vm
+  vers status -l env=staging
+  vers kill -l env=staging,team!=ml`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIShort)
+		defer cancel()
+		target, args := splitLabelTarget(args)
+		view, err := handlers.HandleLabel(apiCtx, application, handlers.LabelReq{Target: target, Args: args})
+		if err != nil {
+			return err
+		}
+
+		format := pres.ParseFormat(false, labelFormat)
+		switch format {
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view)
+		default:
+			pres.RenderLabel(application, view)
+		}
+		return nil
+	},
+}
+
+// splitLabelTarget splits the optional VM off the label arguments. The
+// first argument is the VM unless it parses as key=value or key-, so
+// `vers label team-` removes a label from HEAD.
+func splitLabelTarget(args []string) (string, []string) {
+	if len(args) == 0 {
+		return "", args
+	}
+	if _, _, err := utils.ParseLabelArgs(args[:1]); err == nil {
+		return "", args
+	}
+	return args[0], args[1:]
+}
+
+func init() {
+	rootCmd.AddCommand(labelCmd)
+	labelCmd.Flags().StringVar(&labelFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitLabelTarget(t *testing.T) {
+	tests := []struct {
+		args       []string
+		wantTarget string
+		wantArgs   []string
+	}{
+		{nil, "", nil},
+		{[]string{"my-vm"}, "my-vm", []string{}},
+		{[]string{"my-vm", "env=staging"}, "my-vm", []string{"env=staging"}},
+		{[]string{"my-vm", "team-"}, "my-vm", []string{"team-"}},
+		{[]string{"env=staging", "team=ml"}, "", []string{"env=staging", "team=ml"}},
+		{[]string{"team-"}, "", []string{"team-"}},
+	}
+	for _, tt := range tests {
+		target, args := splitLabelTarget(tt.args)
+		if target != tt.wantTarget || !reflect.DeepEqual(args, tt.wantArgs) {
+			t.Errorf("splitLabelTarget(%q) = %q, %q; want %q, %q", tt.args, target, args, tt.wantTarget, tt.wantArgs)
+		}
+	}
+}
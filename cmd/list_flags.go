@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+// addListFlags registers the --filter, --sort and --columns flags shared by
+// list commands.
+func addListFlags(c *cobra.Command, o *pres.ListOptions, fields string) {
+	c.Flags().StringArrayVar(&o.Filters, "filter", nil, "Only show items where field=value (also !=, >, <, >=, <=; repeatable). Fields: "+fields)
+	c.Flags().StringVar(&o.Sort, "sort", "", "Sort by field; prefix with - for descending")
+	c.Flags().StringVar(&o.Columns, "columns", "", "Comma-separated fields to show as columns")
+}
+
+// listHelp documents the list flags, with examples for the command.
+func listHelp(examples string) string {
+	return `
+
+Use --filter, --sort and --columns to shape the table, and --format with a
+Go template to print each item your own way. = filters accept globs.
+` + examples
+}
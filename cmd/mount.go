@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	"github.com/spf13/cobra"
+)
+
+var mountWebDAV string
+
+var mountCmd = &cobra.Command{
+	Use:   "mount --webdav <addr> [vm-id|alias:]<remote-path>",
+	Short: "Serve a VM directory over WebDAV on localhost",
+	Long: `Expose a directory on a VM as a local WebDAV server, backed by an SFTP session.
+If no VM ID or alias is given before the colon, uses the current HEAD.
+
+Editors and file managers on any OS can then browse and edit the VM's files
+directly. Files can be read, written, created, renamed and deleted; locks are
+held in memory for the lifetime of the server. The server stops on Ctrl-C or
+when the connection to the VM is lost.
+
+If <addr> has no host, the server listens on 127.0.0.1 only.
+
+Examples:
+  vers mount --webdav :8080 my-vm:/workspace
+  vers mount --webdav :8080 /var/www          (uses HEAD VM)
+  vers mount --webdav 127.0.0.1:0 my-vm:/data (pick a free port)`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if mountWebDAV == "" {
+			return fmt.Errorf("--webdav <addr> is required")
+		}
+
+		var spec string
+		if len(args) > 0 {
+			spec = args[0]
+		}
+		target, remotePath := parseRemoteSpec(spec)
+
+		// Use a cancellable context that responds to Ctrl-C
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			<-sigCh
+			cancel()
+		}()
+
+		_, err := handlers.HandleMount(ctx, application, handlers.MountReq{
+			Target:     target,
+			RemotePath: remotePath,
+			Addr:       listenAddr(mountWebDAV),
+		})
+		return err
+	},
+}
+
+// listenAddr defaults the host of a listen address to 127.0.0.1 so the
+// server is never exposed beyond localhost unless asked for explicitly.
+func listenAddr(addr string) string {
+	if !strings.Contains(addr, ":") {
+		return "127.0.0.1:" + addr
+	}
+	if strings.HasPrefix(addr, ":") {
+		return "127.0.0.1" + addr
+	}
+	return addr
+}
+
+func init() {
+	rootCmd.AddCommand(mountCmd)
+	mountCmd.Flags().StringVar(&mountWebDAV, "webdav", "", "Serve over WebDAV on this address (e.g. :8080)")
+}
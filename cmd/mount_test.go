@@ -0,0 +1,17 @@
+package cmd
+
+import "testing"
+
+func TestListenAddr(t *testing.T) {
+	tests := map[string]string{
+		":8080":          "127.0.0.1:8080",
+		"8080":           "127.0.0.1:8080",
+		"0.0.0.0:8080":   "0.0.0.0:8080",
+		"localhost:9000": "localhost:9000",
+	}
+	for in, want := range tests {
+		if got := listenAddr(in); got != want {
+			t.Errorf("listenAddr(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -2,37 +2,58 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hdresearch/vers-cli/internal/handlers"
 	pres "github.com/hdresearch/vers-cli/internal/presenters"
 	"github.com/spf13/cobra"
 )
 
-var pauseFormat string
+var (
+	pauseSelector string
+	pauseBatch    batchFlags
+)
 
 var pauseCmd = &cobra.Command{
 	Use:   "pause [vm-id|alias]",
 	Short: "Pause a running VM",
 	Long: `Pause a running Vers VM. If no VM ID or alias is provided, uses the current HEAD.
 
+Use -l to pause every VM whose labels (see 'vers label') match a selector.
+VMs are paused in parallel (--parallel), transient errors are retried
+(--retries), and a summary is printed at the end; VMs that are already
+paused are left alone:
+  vers pause -l env=staging
+
 Use --format json for machine-readable output.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
-		defer cancel()
 		var target string
 		if len(args) > 0 {
 			target = args[0]
 		}
+
+		if pauseSelector != "" {
+			if target != "" {
+				return fmt.Errorf("cannot use a VM argument together with -l")
+			}
+			return handlers.HandlePauseSelected(context.Background(), application, handlers.PauseSelectedReq{
+				Selector: pauseSelector,
+				Batch:    pauseBatch.opts(),
+			})
+		}
+
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		defer cancel()
+		format := pres.ParseFormat(false, pauseBatch.format)
 		view, err := handlers.HandlePause(apiCtx, application, handlers.PauseReq{Target: target})
 		if err != nil {
 			return err
 		}
 
-		format := pres.ParseFormat(false, pauseFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(map[string]string{"vm_id": view.VMName, "state": view.NewState})
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, map[string]string{"vm_id": view.VMName, "state": view.NewState})
 		default:
 			pres.RenderPause(application, view)
 		}
@@ -42,5 +63,6 @@ Use --format json for machine-readable output.`,
 
 func init() {
 	rootCmd.AddCommand(pauseCmd)
-	pauseCmd.Flags().StringVar(&pauseFormat, "format", "", "Output format (json)")
+	pauseCmd.Flags().StringVarP(&pauseSelector, "selector", "l", "", "Pause every VM matching a label selector (e.g. env=staging)")
+	addBatchFlags(pauseCmd, &pauseBatch)
 }
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+var protectFormat string
+
+var protectCmd = &cobra.Command{
+	Use:   "protect [vm-id|alias]",
+	Short: "Protect a VM from deletion",
+	Long: `Lock a VM so that 'vers kill' refuses to delete it. Deleting a protected VM
+then needs --force and typing the VM's ID, and MCP vers.kill calls are refused.
+Protection is stored locally in ~/.vers/protected.json next to your aliases.
+If no VM ID or alias is provided, protects the current HEAD.
+
+Protected VMs are marked with 🔒 in 'vers status'.
+
+Examples:
+  vers protect my-db
+  vers kill my-db                # refused
+  vers kill --force my-db        # asks you to type the VM ID
+  vers unprotect my-db`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runProtect(args, true)
+	},
+}
+
+var unprotectCmd = &cobra.Command{
+	Use:   "unprotect [vm-id|alias]",
+	Short: "Allow a protected VM to be deleted again",
+	Long: `Remove the lock set by 'vers protect'. If no VM ID or alias is provided,
+unprotects the current HEAD.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runProtect(args, false)
+	},
+}
+
+func runProtect(args []string, protect bool) error {
+	apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIShort)
+	defer cancel()
+	var target string
+	if len(args) > 0 {
+		target = args[0]
+	}
+	view, err := handlers.HandleProtect(apiCtx, application, handlers.ProtectReq{Target: target, Protect: protect})
+	if err != nil {
+		return err
+	}
+
+	format := pres.ParseFormat(false, protectFormat)
+	switch format {
+	case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+		pres.PrintStructured(format, view)
+	default:
+		pres.RenderProtect(application, view)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(protectCmd)
+	rootCmd.AddCommand(unprotectCmd)
+	protectCmd.Flags().StringVar(&protectFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+	unprotectCmd.Flags().StringVar(&protectFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+}
@@ -33,7 +33,7 @@ var repoCreateCmd = &cobra.Command{
 			Name:        args[0],
 			Description: repoCreateDescription,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Repository '%s' created (%s)\n", resp.Name, resp.RepoID)
@@ -46,6 +46,7 @@ var repoCreateCmd = &cobra.Command{
 var (
 	repoListQuiet  bool
 	repoListFormat string
+	repoListOpts   pres.ListOptions
 )
 
 var repoListCmd = &cobra.Command{
@@ -54,7 +55,8 @@ var repoListCmd = &cobra.Command{
 	Long: `List all repositories in your organization.
 
 Use -q/--quiet to output just names (one per line), useful for scripting.
-Use --format json for machine-readable output.`,
+Use --format json for machine-readable output.` + listHelp(`  vers repo list --filter public=true --sort -created
+  vers repo list --format '{{.Name}}'`),
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
@@ -65,7 +67,15 @@ Use --format json for machine-readable output.`,
 			return err
 		}
 
+		spec := pres.RepoListSpec(application.Clock.Now())
+		if res.Repositories, err = pres.ApplyListOptions(spec, res.Repositories, repoListOpts); err != nil {
+			return err
+		}
+
 		format := pres.ParseFormat(repoListQuiet, repoListFormat)
+		if ok, err := pres.RenderList(application, spec, res.Repositories, repoListOpts, format); ok {
+			return err
+		}
 		switch format {
 		case pres.FormatQuiet:
 			names := make([]string, len(res.Repositories))
@@ -73,8 +83,10 @@ Use --format json for machine-readable output.`,
 				names[i] = r.Name
 			}
 			pres.PrintQuiet(names)
-		case pres.FormatJSON:
-			pres.PrintJSON(res.Repositories)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML:
+			pres.PrintStructured(format, res.Repositories)
+		case pres.FormatTemplate:
+			return pres.RenderListTemplate(application, res.Repositories, repoListFormat)
 		default:
 			pres.RenderRepoList(application, pres.RepoListView{Repositories: res.Repositories})
 		}
@@ -106,8 +118,8 @@ Use --format json for machine-readable output.`,
 
 		format := pres.ParseFormat(false, repoGetFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(info)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, info)
 		default:
 			pres.RenderRepoInfo(application, info)
 		}
@@ -117,6 +129,8 @@ Use --format json for machine-readable output.`,
 
 // ── repo delete ──────────────────────────────────────────────────────
 
+var repoDeleteBatch batchFlags
+
 var repoDeleteCmd = &cobra.Command{
 	Use:   "delete <name>...",
 	Short: "Delete one or more repositories",
@@ -126,27 +140,20 @@ The commits themselves are NOT deleted.
 Examples:
   vers repo delete my-app
   vers repo delete my-app staging-env
-  vers repo delete $(vers repo list -q)   # delete all repos`,
+  vers repo delete $(vers repo list -q)   # delete all repos
+  vers repo delete --format json my-app staging-env
+
+Repositories are deleted concurrently (--parallel at a time), and transient
+API errors are retried up to --retries times.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APILong)
 		defer cancel()
 
-		var firstErr error
-		for _, name := range args {
-			err := handlers.HandleRepoDelete(apiCtx, application, handlers.RepoDeleteReq{
-				Name: name,
-			})
-			if err != nil {
-				fmt.Fprintf(cmd.ErrOrStderr(), "✗ Failed to delete repository '%s': %v\n", name, err)
-				if firstErr == nil {
-					firstErr = err
-				}
-				continue
-			}
-			fmt.Printf("✓ Repository '%s' deleted\n", name)
-		}
-		return firstErr
+		return handlers.HandleRepoDeleteMany(apiCtx, application, handlers.RepoDeleteManyReq{
+			Names: args,
+			Batch: repoDeleteBatch.opts(),
+		})
 	},
 }
 
@@ -171,7 +178,7 @@ Examples:
 			Name:     args[0],
 			IsPublic: repoVisibilityPublic,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
@@ -217,7 +224,7 @@ Examples:
 			RepoName:   repoForkRepoName,
 			TagName:    repoForkTagName,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Forked → %s\n", resp.Reference)
@@ -252,7 +259,7 @@ var repoTagCreateCmd = &cobra.Command{
 			CommitID:    args[2],
 			Description: repoTagCreateDescription,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Tag created → %s\n", resp.Reference)
@@ -291,8 +298,8 @@ Use -q/--quiet for just tag names. Use --format json for machine-readable output
 				names[i] = t.TagName
 			}
 			pres.PrintQuiet(names)
-		case pres.FormatJSON:
-			pres.PrintJSON(res.Tags)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, res.Tags)
 		default:
 			pres.RenderRepoTagList(application, pres.RepoTagListView{
 				Repository: res.Repository,
@@ -326,8 +333,8 @@ Use --format json for machine-readable output.`,
 
 		format := pres.ParseFormat(false, repoTagGetFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(info)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, info)
 		default:
 			pres.RenderRepoTagInfo(application, info)
 		}
@@ -359,7 +366,7 @@ var repoTagUpdateCmd = &cobra.Command{
 			CommitID:    repoTagUpdateCommit,
 			Description: repoTagUpdateDescription,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Tag '%s' in '%s' updated\n", args[1], args[0])
@@ -396,6 +403,9 @@ Examples:
 				}
 				continue
 			}
+			if application.DryRun {
+				continue
+			}
 			fmt.Printf("✓ Tag '%s' deleted from '%s'\n", name, repoName)
 		}
 		return firstErr
@@ -451,15 +461,17 @@ func init() {
 
 	// repo list
 	repoListCmd.Flags().BoolVarP(&repoListQuiet, "quiet", "q", false, "Only display repository names")
-	repoListCmd.Flags().StringVar(&repoListFormat, "format", "", "Output format (json)")
+	repoListCmd.Flags().StringVar(&repoListFormat, "format", "", "Output format (json, yaml, csv, ndjson, wide, or a Go template)")
+	addListFlags(repoListCmd, &repoListOpts, "name, id, public, created, age, description")
 	repoCmd.AddCommand(repoListCmd)
 
 	// repo get
-	repoGetCmd.Flags().StringVar(&repoGetFormat, "format", "", "Output format (json)")
+	repoGetCmd.Flags().StringVar(&repoGetFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	repoCmd.AddCommand(repoGetCmd)
 
 	// repo delete
 	repoCmd.AddCommand(repoDeleteCmd)
+	addBatchFlags(repoDeleteCmd, &repoDeleteBatch)
 
 	// repo visibility
 	repoVisibilityCmd.Flags().BoolVar(&repoVisibilityPublic, "public", false, "Set to public (use --public=false for private)")
@@ -477,10 +489,10 @@ func init() {
 	repoTagCmd.AddCommand(repoTagCreateCmd)
 
 	repoTagListCmd.Flags().BoolVarP(&repoTagListQuiet, "quiet", "q", false, "Only display tag names")
-	repoTagListCmd.Flags().StringVar(&repoTagListFormat, "format", "", "Output format (json)")
+	repoTagListCmd.Flags().StringVar(&repoTagListFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	repoTagCmd.AddCommand(repoTagListCmd)
 
-	repoTagGetCmd.Flags().StringVar(&repoTagGetFormat, "format", "", "Output format (json)")
+	repoTagGetCmd.Flags().StringVar(&repoTagGetFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	repoTagCmd.AddCommand(repoTagGetCmd)
 
 	repoTagUpdateCmd.Flags().StringVar(&repoTagUpdateCommit, "commit", "", "Move tag to this commit ID")
@@ -35,14 +35,14 @@ Use --format json for machine-readable output.`,
 			Target:    target,
 			FsSizeMib: resizeDiskSize,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
 		format := pres.ParseFormat(false, resizeFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(map[string]interface{}{"vm_id": vmID, "fs_size_mib": resizeDiskSize})
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, map[string]interface{}{"vm_id": vmID, "fs_size_mib": resizeDiskSize})
 		default:
 			fmt.Printf("✓ Disk resized to %d MiB for VM %s\n", resizeDiskSize, vmID)
 		}
@@ -54,5 +54,5 @@ func init() {
 	rootCmd.AddCommand(resizeCmd)
 	resizeCmd.Flags().Int64Var(&resizeDiskSize, "size", 0, "New disk size in MiB (required, must be greater than current size)")
 	resizeCmd.MarkFlagRequired("size")
-	resizeCmd.Flags().StringVar(&resizeFormat, "format", "", "Output format (json)")
+	resizeCmd.Flags().StringVar(&resizeFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 }
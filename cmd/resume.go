@@ -38,8 +38,8 @@ Use --wait to block until the VM is running.`,
 
 		format := pres.ParseFormat(false, resumeFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(map[string]string{"vm_id": view.VMName, "state": view.NewState})
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, map[string]string{"vm_id": view.VMName, "state": view.NewState})
 		default:
 			pres.RenderResume(application, view)
 		}
@@ -49,6 +49,6 @@ Use --wait to block until the VM is running.`,
 
 func init() {
 	rootCmd.AddCommand(resumeCmd)
-	resumeCmd.Flags().StringVar(&resumeFormat, "format", "", "Output format (json)")
+	resumeCmd.Flags().StringVar(&resumeFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	resumeCmd.Flags().BoolVar(&resumeWait, "wait", false, "Wait until VM is running")
 }
@@ -87,6 +87,10 @@ func init() {
 var (
 	client  *vers.Client
 	verbose bool
+	// outputFormat is the default for every command's --format flag
+	outputFormat string
+	// dryRun makes mutating commands print their API calls instead
+	dryRun bool
 	// application is the dependency container, initialized in PersistentPreRunE
 	application *app.App
 )
@@ -172,6 +176,12 @@ interaction capabilities, and more.`,
 			os.Setenv("VERS_VERBOSE", "true")
 		}
 
+		applyDefaultFormat(cmd)
+
+		if dryRun && cmd.Annotations[dryRunAnnotation] == "" {
+			return fmt.Errorf("--dry-run is not supported by '%s'", cmd.CommandPath())
+		}
+
 		// Skip update check for certain commands
 		skipUpdateCheck := cmd.Name() == "login" ||
 			cmd.Name() == "signup" ||
@@ -236,14 +246,31 @@ interaction capabilities, and more.`,
 			app.OSEnv{},
 			app.RealClock{},
 		)
+		application.DryRun = dryRun
 
 		return nil
 	},
 }
 
+// applyDefaultFormat fills in the command's --format flag from --output or
+// VERS_FORMAT when it wasn't given. The flag isn't marked as changed, so
+// commands can tell an explicit --format from the default.
+func applyDefaultFormat(cmd *cobra.Command) {
+	def := outputFormat
+	if def == "" {
+		def = os.Getenv("VERS_FORMAT")
+	}
+	f := cmd.Flags().Lookup("format")
+	if def == "" || f == nil || f.Changed {
+		return
+	}
+	f.Value.Set(def)
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
+	rootCmd.SetArgs(expandExecTTY(routeExecSubcommands(os.Args[1:])))
 	err := rootCmd.Execute()
 	if err != nil {
 		code := errorsx.ExitCodeFromError(err)
@@ -257,6 +284,8 @@ func Execute() {
 func init() {
 	// Add global persistent flags
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "V", false, "Enable verbose output")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print the API calls a command would make, without making them or changing HEAD and aliases")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "Default output format for commands with --format (json, yaml, csv, ndjson, wide); also read from VERS_FORMAT")
 
 	// Add version flags
 	rootCmd.Flags().Bool("version", false, "Show version information")
@@ -18,6 +18,7 @@ func TestSubcommandRouting(t *testing.T) {
 	}{
 		{"commit", []string{"create", "list", "delete", "history", "publish", "unpublish"}},
 		{"tag", []string{"create", "list", "get", "update", "delete"}},
+		{"exec", []string{"attach", "history"}},
 	}
 
 	for _, tt := range tests {
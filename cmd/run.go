@@ -41,14 +41,14 @@ Use --wait to block until the VM is running.`,
 			Wait:        runWait,
 		}
 		view, err := handlers.HandleRun(apiCtx, application, req)
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
 		format := pres.ParseFormat(false, runFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(view)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view)
 		default:
 			pres.RenderRun(application, view)
 		}
@@ -65,6 +65,6 @@ func init() {
 	runCmd.Flags().String("kernel", "", "Override kernel name")
 	runCmd.Flags().Int64("fs-size-vm", 0, "Override VM filesystem size (MiB)")
 	runCmd.Flags().StringVarP(&vmAlias, "vm-alias", "N", "", "Set an alias for the root VM")
-	runCmd.Flags().StringVar(&runFormat, "format", "", "Output format (json)")
+	runCmd.Flags().StringVar(&runFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	runCmd.Flags().BoolVar(&runWait, "wait", false, "Wait until VM is running before returning")
 }
@@ -35,14 +35,14 @@ Use --wait to block until the VM is running.`,
 		defer cancel()
 		req := handlers.RunCommitReq{CommitKey: commitKey, VMAlias: commitVmAlias, Wait: runCommitWait}
 		view, err := handlers.HandleRunCommit(apiCtx, application, req)
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 
 		format := pres.ParseFormat(false, runCommitFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(view)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, view)
 		default:
 			pres.RenderRunCommit(application, view)
 		}
@@ -54,6 +54,6 @@ func init() {
 	rootCmd.AddCommand(runCommitCmd)
 
 	runCommitCmd.Flags().StringVarP(&commitVmAlias, "vm-alias", "N", "", "Set an alias for the root VM")
-	runCommitCmd.Flags().StringVar(&runCommitFormat, "format", "", "Output format (json)")
+	runCommitCmd.Flags().StringVar(&runCommitFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	runCommitCmd.Flags().BoolVar(&runCommitWait, "wait", false, "Wait until VM is running")
 }
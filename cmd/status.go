@@ -2,15 +2,27 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/hdresearch/vers-cli/internal/handlers"
 	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var (
-	statusQuiet  bool
-	statusFormat string
+	statusQuiet    bool
+	statusFormat   string
+	statusSelector string
+	statusWatch    bool
+	statusInterval time.Duration
+	statusList     pres.ListOptions
 )
 
 // statusCmd represents the status command
@@ -23,7 +35,20 @@ Use -q/--quiet to output just VM IDs (one per line), useful for scripting:
   vers kill $(vers status -q)              # kill all VMs
   vers info $(vers status -q | head -1)    # info on first VM
 
-Use --format json for machine-readable output.`,
+Use -l to list only VMs whose labels (see 'vers label') match a selector:
+  vers status -l env=staging
+
+Use --watch to keep the table on screen and refresh it every --interval.
+State changes are highlighted, and AGE and UPTIME columns show how long
+ago each VM was created and how long it has been running. Press Ctrl-C to
+quit. When stdout is not a terminal (or with a structured --format),
+--watch prints one JSON line per change instead:
+  vers status --watch --interval 5s
+  vers status --watch | jq -c 'select(.type == "state")'
+
+Use --format json for machine-readable output.` + listHelp(`  vers status --filter state=running --filter 'age>24h' --sort -created
+  vers status --columns id,alias,state,age,labels
+  vers status --format '{{.VmID}} {{.State}}'`),
 	Aliases: []string{"ps"},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var target string
@@ -31,15 +56,42 @@ Use --format json for machine-readable output.`,
 			target = args[0]
 		}
 
+		if target != "" && statusSelector != "" {
+			return fmt.Errorf("cannot use a VM argument together with -l")
+		}
+
+		if statusWatch {
+			if target != "" {
+				return fmt.Errorf("--watch shows all VMs; use -l to narrow it down")
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return handlers.HandleStatusWatch(ctx, application, handlers.StatusWatchReq{
+				Selector: statusSelector,
+				Interval: statusInterval,
+				JSON:     pres.ParseFormat(false, statusFormat).Structured() || !term.IsTerminal(int(os.Stdout.Fd())),
+			})
+		}
+
 		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
 		defer cancel()
 
-		res, err := handlers.HandleStatus(apiCtx, application, handlers.StatusReq{Target: target})
+		res, err := handlers.HandleStatus(apiCtx, application, handlers.StatusReq{Target: target, Selector: statusSelector})
 		if err != nil {
 			return err
 		}
 
 		format := pres.ParseFormat(statusQuiet, statusFormat)
+		if res.Mode == pres.StatusList {
+			spec := statusListSpec()
+			if res.VMs, err = pres.ApplyListOptions(spec, res.VMs, statusList); err != nil {
+				return err
+			}
+			if ok, err := pres.RenderList(application, spec, res.VMs, statusList, format); ok {
+				return err
+			}
+		}
+
 		switch format {
 		case pres.FormatQuiet:
 			if res.Mode == pres.StatusVM && res.VM != nil {
@@ -51,12 +103,17 @@ Use --format json for machine-readable output.`,
 				}
 				pres.PrintQuiet(ids)
 			}
-		case pres.FormatJSON:
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
 			if res.Mode == pres.StatusVM && res.VM != nil {
-				pres.PrintJSON(res.VM)
+				pres.PrintStructured(format, res.VM)
 			} else {
-				pres.PrintJSON(res.VMs)
+				pres.PrintStructured(format, res.VMs)
+			}
+		case pres.FormatTemplate:
+			if res.Mode == pres.StatusVM && res.VM != nil {
+				return pres.RenderListTemplate(application, []vers.Vm{*res.VM}, statusFormat)
 			}
+			return pres.RenderListTemplate(application, res.VMs, statusFormat)
 		default:
 			pres.RenderStatus(application, res)
 		}
@@ -67,5 +124,28 @@ Use --format json for machine-readable output.`,
 func init() {
 	rootCmd.AddCommand(statusCmd)
 	statusCmd.Flags().BoolVarP(&statusQuiet, "quiet", "q", false, "Only display VM IDs")
-	statusCmd.Flags().StringVar(&statusFormat, "format", "", "Output format (json)")
+	statusCmd.Flags().StringVar(&statusFormat, "format", "", "Output format (json, yaml, csv, ndjson, wide, or a Go template)")
+	addListFlags(statusCmd, &statusList, "id, alias, state, created, age, owner, labels, protected")
+	statusCmd.Flags().BoolVarP(&statusWatch, "watch", "w", false, "Keep refreshing the VM list until interrupted")
+	statusCmd.Flags().DurationVar(&statusInterval, "interval", 2*time.Second, "Refresh interval for --watch")
+	statusCmd.Flags().StringVarP(&statusSelector, "selector", "l", "", "Only show VMs matching a label selector (e.g. env=staging)")
+}
+
+// statusListSpec describes the VM list columns, with aliases, labels and
+// protection from local state.
+func statusListSpec() pres.ListSpec[vers.Vm] {
+	aliases := map[string]string{}
+	if all, err := utils.LoadAliases(); err == nil {
+		for alias, id := range all {
+			aliases[id] = alias
+		}
+	}
+	labels := map[string]string{}
+	if all, err := utils.LoadLabels(); err == nil {
+		for id, l := range all {
+			labels[id] = utils.FormatLabels(l)
+		}
+	}
+	protected, _ := utils.LoadProtected()
+	return pres.VMListSpec(application.Clock.Now(), aliases, labels, protected)
 }
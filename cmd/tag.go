@@ -32,7 +32,7 @@ var tagCreateCmd = &cobra.Command{
 			CommitID:    args[1],
 			Description: tagCreateDescription,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Tag '%s' created → %s\n", resp.TagName, resp.CommitID)
@@ -43,6 +43,7 @@ var tagCreateCmd = &cobra.Command{
 var (
 	tagListQuiet  bool
 	tagListFormat string
+	tagListOpts   pres.ListOptions
 )
 
 var tagListCmd = &cobra.Command{
@@ -53,7 +54,8 @@ var tagListCmd = &cobra.Command{
 Use -q/--quiet to output just tag names (one per line), useful for scripting:
   vers tag delete $(vers tag list -q)   # delete all tags
 
-Use --format json for machine-readable output.`,
+Use --format json for machine-readable output.` + listHelp(`  vers tag list --filter 'age<7d' --sort name
+  vers tag list --format '{{.TagName}}={{.CommitID}}'`),
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
@@ -64,7 +66,15 @@ Use --format json for machine-readable output.`,
 			return err
 		}
 
+		spec := pres.TagListSpec(application.Clock.Now())
+		if res.Tags, err = pres.ApplyListOptions(spec, res.Tags, tagListOpts); err != nil {
+			return err
+		}
+
 		format := pres.ParseFormat(tagListQuiet, tagListFormat)
+		if ok, err := pres.RenderList(application, spec, res.Tags, tagListOpts, format); ok {
+			return err
+		}
 		switch format {
 		case pres.FormatQuiet:
 			names := make([]string, len(res.Tags))
@@ -72,8 +82,10 @@ Use --format json for machine-readable output.`,
 				names[i] = t.TagName
 			}
 			pres.PrintQuiet(names)
-		case pres.FormatJSON:
-			pres.PrintJSON(res.Tags)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML:
+			pres.PrintStructured(format, res.Tags)
+		case pres.FormatTemplate:
+			return pres.RenderListTemplate(application, res.Tags, tagListFormat)
 		default:
 			pres.RenderTagList(application, res)
 		}
@@ -103,8 +115,8 @@ Use --format json for machine-readable output.`,
 
 		format := pres.ParseFormat(false, tagGetFormat)
 		switch format {
-		case pres.FormatJSON:
-			pres.PrintJSON(info)
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			pres.PrintStructured(format, info)
 		default:
 			pres.RenderTagInfo(application, info)
 		}
@@ -135,7 +147,7 @@ var tagUpdateCmd = &cobra.Command{
 			CommitID:    tagUpdateCommit,
 			Description: tagUpdateDescription,
 		})
-		if err != nil {
+		if err != nil || application.DryRun {
 			return err
 		}
 		fmt.Printf("✓ Tag '%s' updated\n", args[0])
@@ -143,6 +155,8 @@ var tagUpdateCmd = &cobra.Command{
 	},
 }
 
+var tagDeleteBatch batchFlags
+
 var tagDeleteCmd = &cobra.Command{
 	Use:   "delete <tag-name>...",
 	Short: "Delete one or more tags",
@@ -151,27 +165,20 @@ var tagDeleteCmd = &cobra.Command{
 Examples:
   vers tag delete staging
   vers tag delete staging production
-  vers tag delete $(vers tag list -q)   # delete all tags`,
+  vers tag delete $(vers tag list -q)   # delete all tags
+  vers tag delete --format json staging production
+
+Tags are deleted concurrently (--parallel at a time), and transient API
+errors are retried up to --retries times.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APIMedium)
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APILong)
 		defer cancel()
 
-		var firstErr error
-		for _, name := range args {
-			err := handlers.HandleTagDelete(apiCtx, application, handlers.TagDeleteReq{
-				TagName: name,
-			})
-			if err != nil {
-				fmt.Fprintf(cmd.ErrOrStderr(), "✗ Failed to delete tag '%s': %v\n", name, err)
-				if firstErr == nil {
-					firstErr = err
-				}
-				continue
-			}
-			fmt.Printf("✓ Tag '%s' deleted\n", name)
-		}
-		return firstErr
+		return handlers.HandleTagDeleteMany(apiCtx, application, handlers.TagDeleteManyReq{
+			TagNames: args,
+			Batch:    tagDeleteBatch.opts(),
+		})
 	},
 }
 
@@ -182,10 +189,11 @@ func init() {
 	tagCmd.AddCommand(tagCreateCmd)
 
 	tagListCmd.Flags().BoolVarP(&tagListQuiet, "quiet", "q", false, "Only display tag names")
-	tagListCmd.Flags().StringVar(&tagListFormat, "format", "", "Output format (json)")
+	tagListCmd.Flags().StringVar(&tagListFormat, "format", "", "Output format (json, yaml, csv, ndjson, wide, or a Go template)")
+	addListFlags(tagListCmd, &tagListOpts, "name, commit, created, updated, age, description")
 	tagCmd.AddCommand(tagListCmd)
 
-	tagGetCmd.Flags().StringVar(&tagGetFormat, "format", "", "Output format (json)")
+	tagGetCmd.Flags().StringVar(&tagGetFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
 	tagCmd.AddCommand(tagGetCmd)
 
 	tagUpdateCmd.Flags().StringVar(&tagUpdateCommit, "commit", "", "Move tag to this commit ID")
@@ -193,4 +201,5 @@ func init() {
 	tagCmd.AddCommand(tagUpdateCmd)
 
 	tagCmd.AddCommand(tagDeleteCmd)
+	addBatchFlags(tagDeleteCmd, &tagDeleteBatch)
 }
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+var treeFormat string
+
+var treeCmd = &cobra.Command{
+	Use:   "tree [vm-id|alias|commit-id]",
+	Short: "Show the lineage of your VMs and commits as a tree",
+	Long: `Draw how your VMs and commits relate: every VM hangs off the commit it was
+started or branched from, which hangs off the VM it was taken from. States,
+aliases and the current HEAD are marked. VMs that have since been deleted but
+still have descendants are shown as [deleted].
+
+Provide a VM ID, alias or commit ID to show only the tree below it.
+
+Use --format dot or --format mermaid to export the tree for Graphviz or
+Markdown docs, or --format json for a nested JSON document.
+
+Examples:
+  vers tree
+  vers tree base-vm
+  vers tree --format dot | dot -Tsvg > lineage.svg`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiCtx, cancel := context.WithTimeout(context.Background(), application.Timeouts.APILong)
+		defer cancel()
+		var root string
+		if len(args) > 0 {
+			root = args[0]
+		}
+
+		switch treeFormat {
+		case "", "text", "json", "yaml", "yml", "dot", "mermaid":
+		default:
+			if !cmd.Flags().Changed("format") {
+				// A global --output default tree can't render
+				treeFormat = "text"
+				break
+			}
+			return fmt.Errorf("invalid format %q: must be text, json, yaml, dot or mermaid", treeFormat)
+		}
+
+		view, err := handlers.HandleTree(apiCtx, application, handlers.TreeReq{Root: root})
+		if err != nil {
+			return err
+		}
+
+		switch treeFormat {
+		case "json", "yaml", "yml":
+			roots := view.Roots
+			if roots == nil {
+				roots = []*pres.TreeNode{}
+			}
+			pres.PrintStructured(pres.ParseFormat(false, treeFormat), roots)
+		case "dot":
+			pres.RenderTreeDot(application, view)
+		case "mermaid":
+			pres.RenderTreeMermaid(application, view)
+		default:
+			pres.RenderTree(application, view)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+	treeCmd.Flags().StringVar(&treeFormat, "format", "", "Output format (text, json, yaml, dot, mermaid)")
+}
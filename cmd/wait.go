@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/handlers"
+	pres "github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/spf13/cobra"
+)
+
+var (
+	waitFor      []string
+	waitTimeout  time.Duration
+	waitInterval time.Duration
+	waitFormat   string
+)
+
+var waitCmd = &cobra.Command{
+	Use:   "wait [vm-id|alias]",
+	Short: "Wait for a VM to reach a condition",
+	Long: `Block until a VM meets one or more conditions. If no VM ID or alias is
+provided, uses the current HEAD.
+
+Conditions are given with --for and waited for in order:
+  state=running|paused|booting   the VM is in the given state
+  state=deleted                  the VM no longer exists
+  port=N or port=HOST:N          something accepts TCP connections inside the VM
+  http=URL                       URL returns a 2xx/3xx response from inside the VM
+  cmd=COMMAND                    COMMAND exits 0 inside the VM
+
+Without --for, waits for state=running. Exits with status 6 on timeout.
+
+Examples:
+  vers wait
+  vers wait my-vm --for port=8080
+  vers wait my-vm --for state=running --for http=http://localhost:8080/health --timeout 5m
+  vers wait --for 'cmd=test -f /tmp/ready'
+  vers wait old-vm --for state=deleted`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
+		defer cancel()
+		var target string
+		if len(args) > 0 {
+			target = args[0]
+		}
+		view, err := handlers.HandleWait(ctx, application, handlers.WaitReq{
+			Target:   target,
+			For:      waitFor,
+			Interval: waitInterval,
+		})
+		if err != nil {
+			return err
+		}
+
+		format := pres.ParseFormat(false, waitFormat)
+		switch format {
+		case pres.FormatJSON, pres.FormatNDJSON, pres.FormatYAML, pres.FormatCSV:
+			conds := make([]map[string]interface{}, len(view.Conditions))
+			for i, c := range view.Conditions {
+				conds[i] = map[string]interface{}{"condition": c.Spec, "elapsed_ms": c.Elapsed.Milliseconds()}
+			}
+			pres.PrintStructured(format, map[string]interface{}{"vm_id": view.VMID, "conditions": conds})
+		default:
+			pres.RenderWait(application, view)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(waitCmd)
+	waitCmd.Flags().StringArrayVar(&waitFor, "for", nil, "Condition to wait for: state=..., port=..., http=... or cmd=... (repeatable)")
+	waitCmd.Flags().DurationVar(&waitTimeout, "timeout", 5*time.Minute, "Maximum time to wait")
+	waitCmd.Flags().DurationVar(&waitInterval, "interval", 0, "Polling interval (default 2s)")
+	waitCmd.Flags().StringVar(&waitFormat, "format", "", "Output format (json, yaml, csv, ndjson)")
+}
@@ -11,7 +11,9 @@ require (
 	github.com/pkg/sftp v1.13.10
 	github.com/spf13/cobra v1.9.1
 	golang.org/x/crypto v0.46.0
+	golang.org/x/net v0.48.0
 	golang.org/x/term v0.38.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
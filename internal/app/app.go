@@ -21,6 +21,7 @@ type App struct {
 	Timeouts Timeouts
 	BaseURL  *url.URL
 	Verbose  bool
+	DryRun   bool // print mutating API calls instead of making them
 }
 
 // New constructs an App.
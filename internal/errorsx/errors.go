@@ -1 +1,16 @@
 package errorsx
+
+import "fmt"
+
+// TimeoutError reports that an operation gave up waiting. ExitCodeFromError
+// maps it to ExitTimeout whatever IDs or URLs its message contains.
+type TimeoutError struct {
+	Op  string // what was being waited for
+	Err error  // usually ctx.Err()
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out waiting for %s: %v", e.Op, e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error { return e.Err }
@@ -1,6 +1,9 @@
 package errorsx
 
-import "strings"
+import (
+	"errors"
+	"strings"
+)
 
 // Exit codes for structured error handling in scripts and agents.
 const (
@@ -19,6 +22,10 @@ func ExitCodeFromError(err error) int {
 	if err == nil {
 		return ExitOK
 	}
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return ExitTimeout
+	}
 	s := err.Error()
 	lower := strings.ToLower(s)
 
@@ -21,6 +21,7 @@ func TestExitCodeFromError(t *testing.T) {
 		{errors.New("400 bad request"), errorsx.ExitBadRequest},
 		{errors.New("context deadline exceeded"), errorsx.ExitTimeout},
 		{errors.New("timed out waiting"), errorsx.ExitTimeout},
+		{&errorsx.TimeoutError{Op: "VM 4013e1a9 at https://401.example.com", Err: errors.New("deadline exceeded")}, errorsx.ExitTimeout},
 		{errors.New("operation cancelled by user"), errorsx.ExitCancelled},
 		{errors.New("something random"), errorsx.ExitGeneral},
 	}
@@ -36,3 +37,26 @@ func TestExitCodeFromError(t *testing.T) {
 		}
 	}
 }
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("429 Too Many Requests"), true},
+		{errors.New(`DELETE "/api/v1/vm/abc": 503 Service Unavailable`), true},
+		{errors.New("502 Bad Gateway"), true},
+		{errors.New("read tcp: connection reset by peer"), true},
+		{errors.New("404 not found"), false},
+		{errors.New("409 conflict"), false},
+		{errors.New("VM 'a5030b1c' not found"), false},
+		{errors.New("operation cancelled by user"), false},
+	}
+
+	for _, tt := range tests {
+		if got := errorsx.IsTransient(tt.err); got != tt.want {
+			t.Errorf("IsTransient(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
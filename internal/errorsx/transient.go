@@ -0,0 +1,38 @@
+package errorsx
+
+import (
+	"errors"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// transientStatus matches the HTTP status codes worth retrying as whole
+// words, so IDs that happen to contain the digits don't count.
+var transientStatus = regexp.MustCompile(`\b(429|500|502|503|504)\b`)
+
+// IsTransient reports whether err looks like a failure that may succeed if
+// the request is retried: rate limiting, a 5xx from the API or gateway, or a
+// dropped connection.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	s := err.Error()
+	lower := strings.ToLower(s)
+
+	switch {
+	case transientStatus.MatchString(s) || strings.Contains(lower, "too many requests") ||
+		strings.Contains(lower, "bad gateway") || strings.Contains(lower, "service unavailable"):
+		return true
+	case strings.Contains(lower, "connection reset") || strings.Contains(lower, "connection refused") ||
+		strings.Contains(lower, "unexpected eof") || strings.Contains(lower, "broken pipe"):
+		return true
+	default:
+		return false
+	}
+}
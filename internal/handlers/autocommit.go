@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+// autocommitStartTimeout bounds how long `--daemon` waits for the
+// background process to take the VM's autocommit lock.
+const autocommitStartTimeout = 5 * time.Second
+
+type AutocommitReq struct {
+	Target string
+	Every  time.Duration
+	Keep   int  // auto-commits to keep; 0 keeps them all
+	Daemon bool // run the loop in a background process
+	Stop   bool // stop the background process
+}
+
+// HandleAutocommit commits a VM every r.Every until ctx is cancelled,
+// deleting auto-commits older than the newest r.Keep. Commits with a tag,
+// whether a legacy tag or a repository tag, are never deleted. The commit
+// IDs are recorded in ~/.vers/autocommit so retention carries across runs.
+func HandleAutocommit(ctx context.Context, a *app.App, r AutocommitReq) error {
+	if !r.Stop {
+		if r.Every < time.Minute {
+			return fmt.Errorf("--every must be at least 1m")
+		}
+		if r.Keep < 0 {
+			return fmt.Errorf("--keep must not be negative")
+		}
+	}
+
+	rctx, cancel := context.WithTimeout(ctx, a.Timeouts.APIShort)
+	resolved, err := utils.ResolveTargetVM(rctx, a.Client, r.Target)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case r.Stop:
+		return stopAutocommitDaemon(a, resolved.ID)
+	case r.Daemon:
+		return startAutocommitDaemon(a, resolved.ID, r)
+	}
+
+	lock, err := utils.LockAutocommit(resolved.ID)
+	if err != nil {
+		if errors.Is(err, utils.ErrAutocommitRunning) {
+			return alreadyAutocommitting(resolved.ID, utils.AutocommitPID(resolved.ID))
+		}
+		return err
+	}
+	defer lock.Release()
+
+	presenters.AutocommitStarted(a, resolved.ID, r.Every, r.Keep)
+	for {
+		if err := autocommitOnce(ctx, a, resolved.ID, r.Keep); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			// The VM is gone; there is nothing left to commit
+			if utils.IsNotFound(err) {
+				return err
+			}
+			presenters.AutocommitWarning(a, a.Clock.Now(), err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.Every):
+		}
+	}
+}
+
+// autocommitOnce commits the VM and deletes expired auto-commits. Only a
+// failed commit is returned; cleanup problems are printed as warnings and
+// retried next time.
+func autocommitOnce(ctx context.Context, a *app.App, vmID string, keep int) error {
+	ctx, cancel := context.WithTimeout(ctx, a.Timeouts.APILong)
+	defer cancel()
+
+	resp, err := a.Client.Vm.Commit(ctx, vmID, vers.VmCommitParams{})
+	if err != nil {
+		return fmt.Errorf("failed to commit VM '%s': %w", vmID, err)
+	}
+	now := a.Clock.Now()
+	presenters.AutocommitCommitted(a, now, resp.CommitID)
+
+	rec, err := utils.LoadAutocommitRecord(vmID)
+	if err != nil {
+		presenters.AutocommitWarning(a, now, err)
+		return nil
+	}
+	rec.Commits = append(rec.Commits, utils.AutoCommit{CommitID: resp.CommitID, CreatedAt: now})
+	if err := utils.SaveAutocommitRecord(rec); err != nil {
+		presenters.AutocommitWarning(a, now, err)
+		return nil
+	}
+
+	expired := rec.Expired(keep)
+	if len(expired) == 0 {
+		return nil
+	}
+	tagged, err := taggedCommitIDs(ctx, a)
+	if err != nil {
+		presenters.AutocommitWarning(a, now, fmt.Errorf("not deleting old auto-commits: %w", err))
+		return nil
+	}
+	for _, c := range expired {
+		if tagged[c.CommitID] {
+			continue
+		}
+		err := a.Client.Commits.Delete(ctx, c.CommitID)
+		if err != nil && !utils.IsNotFound(err) {
+			presenters.AutocommitWarning(a, now, fmt.Errorf("failed to delete commit '%s': %w", c.CommitID, err))
+			continue
+		}
+		rec.Forget(c.CommitID)
+		if err == nil {
+			presenters.AutocommitPruned(a, now, c.CommitID)
+		}
+	}
+	if err := utils.SaveAutocommitRecord(rec); err != nil {
+		presenters.AutocommitWarning(a, now, err)
+	}
+	return nil
+}
+
+// taggedCommitIDs returns every commit that a legacy tag or a repository
+// tag points at.
+func taggedCommitIDs(ctx context.Context, a *app.App) (map[string]bool, error) {
+	tagged := map[string]bool{}
+	tags, err := a.Client.CommitTags.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	if tags == nil {
+		return nil, fmt.Errorf("empty response from API")
+	}
+	for _, t := range tags.Tags {
+		tagged[t.CommitID] = true
+	}
+
+	repos, err := a.Client.Repositories.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+	for _, repo := range repos.Repositories {
+		resp, err := a.Client.Repositories.ListTags(ctx, repo.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags for '%s': %w", repo.Name, err)
+		}
+		for _, t := range resp.Tags {
+			tagged[t.CommitID] = true
+		}
+	}
+	return tagged, nil
+}
+
+// startAutocommitDaemon re-runs `vers autocommit` in a background process
+// that logs to ~/.vers/autocommit/<vm-id>.log, and waits until that process
+// holds the VM's autocommit lock.
+func startAutocommitDaemon(a *app.App, vmID string, r AutocommitReq) error {
+	if runtime.GOOS == "windows" {
+		return fmt.Errorf("--daemon is not supported on Windows; run autocommit in the foreground instead")
+	}
+	pid, running, err := utils.AutocommitOwner(vmID)
+	if err != nil {
+		return err
+	}
+	if running {
+		return alreadyAutocommitting(vmID, pid)
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find the vers executable: %w", err)
+	}
+	dir, err := utils.GetAutocommitDir()
+	if err != nil {
+		return err
+	}
+	logPath := filepath.Join(dir, vmID+".log")
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer logFile.Close()
+
+	cmd := exec.Command(self, "autocommit", vmID, "--every", r.Every.String(), "--keep", strconv.Itoa(r.Keep))
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = utils.DetachedProcAttr()
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start background process: %w", err)
+	}
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	// The child records its own PID once it holds the lock. Probing the
+	// lock here could make the child lose it, so only the PID is polled.
+	deadline := time.After(autocommitStartTimeout)
+	for {
+		if utils.AutocommitPID(vmID) == cmd.Process.Pid {
+			presenters.AutocommitDaemonStarted(a, vmID, cmd.Process.Pid, logPath)
+			return nil
+		}
+		select {
+		case err := <-exited:
+			if err == nil {
+				err = fmt.Errorf("exited")
+			}
+			return fmt.Errorf("background process failed to start (%v); see %s", err, logPath)
+		case <-deadline:
+			cmd.Process.Kill()
+			return fmt.Errorf("background process did not start within %s; see %s", autocommitStartTimeout, logPath)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// stopAutocommitDaemon terminates the process holding the VM's autocommit
+// lock. The lock proves the process is a live `vers autocommit` for this
+// VM, so a stale or reused PID is never signalled.
+func stopAutocommitDaemon(a *app.App, vmID string) error {
+	pid, running, err := utils.AutocommitOwner(vmID)
+	if err != nil {
+		return err
+	}
+	if !running {
+		return fmt.Errorf("VM '%s' is not being auto-committed", vmID)
+	}
+	if pid == 0 {
+		return fmt.Errorf("autocommit for VM '%s' is still starting; try again", vmID)
+	}
+	if err := utils.TerminateProcess(pid); err != nil {
+		return fmt.Errorf("failed to stop autocommit (pid %d): %w", pid, err)
+	}
+	presenters.AutocommitDaemonStopped(a, vmID, pid)
+	return nil
+}
+
+func alreadyAutocommitting(vmID string, pid int) error {
+	return fmt.Errorf("VM '%s' is already being auto-committed (pid %d); stop it with 'vers autocommit %s --stop'", vmID, pid, vmID)
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// BatchOpts are the settings shared by commands that act on several
+// targets: how many to process at once, how often to retry transient
+// errors, and how to print the summary.
+type BatchOpts struct {
+	Parallel int // 0 means utils.DefaultBatchParallel
+	Retries  int
+	Format   presenters.OutputFormat
+	// ItemTimeout, if set, bounds each attempt at a target on its own, so
+	// slow targets late in the batch aren't starved by an overall deadline.
+	ItemTimeout time.Duration
+	// Action is the wording of the per-target lines; the zero value means
+	// presenters.BatchDelete.
+	Action presenters.BatchAction
+}
+
+// runBatch deletes (or otherwise processes) every target with
+// utils.RunBatch. A line is printed as each target finishes and a summary
+// at the end; structured formats print only the summary. op returns the ID
+// of the item it acted on and may record extra details, such as a safety
+// commit, in item; item is kept across retries. The first error, if any,
+// is returned.
+func runBatch(ctx context.Context, a *app.App, targets []string, o BatchOpts, itemType, noun string, op func(ctx context.Context, target string, item *presenters.SummaryItem) (string, error)) ([]utils.BatchResult, error) {
+	structured := o.Format.Structured()
+	if o.Action == (presenters.BatchAction{}) {
+		o.Action = presenters.BatchDelete
+	}
+	items := make([]presenters.SummaryItem, len(targets))
+	results := utils.RunBatch(ctx, targets, utils.BatchOptions{
+		Parallel: o.Parallel,
+		Retries:  o.Retries,
+		OnResult: func(done int, r utils.BatchResult) {
+			summarizeItem(&items[r.Index], r)
+			if !structured {
+				presenters.BatchItemResult(done, len(targets), o.Action, noun, items[r.Index])
+			}
+		},
+	}, func(ctx context.Context, i int, target string) (string, error) {
+		if o.ItemTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, o.ItemTimeout)
+			defer cancel()
+		}
+		return op(ctx, target, &items[i])
+	})
+
+	var firstErr error
+	for _, r := range results {
+		if r.Err != nil && firstErr == nil {
+			firstErr = r.Err
+		}
+	}
+	if structured || len(targets) > 1 {
+		if err := presenters.PrintDeletionSummary(presenters.Summarize(itemType, items), o.Format); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return results, firstErr
+}
+
+// summarizeItem fills in the outcome of r, keeping details op recorded.
+func summarizeItem(item *presenters.SummaryItem, r utils.BatchResult) {
+	item.Target = r.Item
+	item.ID = r.ID
+	item.OK = r.Err == nil
+	item.Attempts = r.Attempts
+	item.DurationMs = r.Duration.Milliseconds()
+	if r.Err != nil {
+		item.Error = r.Err.Error()
+	}
+}
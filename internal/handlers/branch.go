@@ -48,6 +48,17 @@ func HandleBranch(ctx context.Context, a *app.App, r BranchReq) (presenters.Bran
 	res.FromID = vmID
 	res.FromName = vmID
 
+	step := presenters.DryRunStep{Call: "Vm.Branch", Args: []string{vmID}, Payload: map[string]int{"count": count}}
+	if r.Alias != "" {
+		step.Local = append(step.Local, fmt.Sprintf("save alias '%s' for the new VM", r.Alias))
+	}
+	if r.Checkout {
+		step.Local = append(step.Local, "set HEAD to the new VM")
+	}
+	if dryRun(a, step) {
+		return res, nil
+	}
+
 	// Note: Alias parameter no longer supported in new SDK
 	// SDK alpha.24 now returns the new VM ID
 	resp, err := a.Client.Vm.Branch(ctx, vmID, vers.VmBranchParams{Count: vers.F(int64(count))})
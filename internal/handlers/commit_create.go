@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
 	"github.com/hdresearch/vers-cli/internal/utils"
 	vers "github.com/hdresearch/vers-sdk-go"
 )
@@ -25,6 +26,10 @@ func HandleCommitCreate(ctx context.Context, a *app.App, r CommitCreateReq) (Com
 		return CommitCreateView{}, err
 	}
 
+	if dryRun(a, presenters.DryRunStep{Call: "Vm.Commit", Args: []string{resolved.ID}}) {
+		return CommitCreateView{VmID: resolved.ID, UsedHEAD: resolved.UsedHEAD}, nil
+	}
+
 	resp, err := a.Client.Vm.Commit(ctx, resolved.ID, vers.VmCommitParams{})
 	if err != nil {
 		return CommitCreateView{}, fmt.Errorf("failed to commit VM '%s': %w", resolved.ID, err)
@@ -52,6 +52,27 @@ func HandleCommitDelete(ctx context.Context, a *app.App, r CommitDeleteReq) erro
 	return nil
 }
 
+// CommitDeleteManyReq is the request for deleting several commits.
+type CommitDeleteManyReq struct {
+	CommitIDs []string
+	Batch     BatchOpts
+}
+
+// HandleCommitDeleteMany deletes commits concurrently with the batch engine,
+// reporting each result and a summary.
+func HandleCommitDeleteMany(ctx context.Context, a *app.App, r CommitDeleteManyReq) error {
+	if len(r.CommitIDs) == 0 {
+		return fmt.Errorf("commit ID is required")
+	}
+	if dryRun(a, deleteSteps("Commits.Delete", r.CommitIDs)...) {
+		return nil
+	}
+	_, err := runBatch(ctx, a, r.CommitIDs, r.Batch, "commits", "commit", func(ctx context.Context, id string, _ *presenters.SummaryItem) (string, error) {
+		return id, a.Client.Commits.Delete(ctx, id)
+	})
+	return err
+}
+
 // CommitUpdateReq is the request for updating a commit.
 type CommitUpdateReq struct {
 	CommitID string
@@ -62,11 +83,15 @@ func HandleCommitUpdate(ctx context.Context, a *app.App, r CommitUpdateReq) (*ve
 	if r.CommitID == "" {
 		return nil, fmt.Errorf("commit ID is required")
 	}
-	info, err := a.Client.Commits.Update(ctx, r.CommitID, vers.CommitUpdateParams{
+	params := vers.CommitUpdateParams{
 		UpdateCommitRequest: vers.UpdateCommitRequestParam{
 			IsPublic: vers.F(r.IsPublic),
 		},
-	})
+	}
+	if dryRun(a, presenters.DryRunStep{Call: "Commits.Update", Args: []string{r.CommitID}, Payload: params.UpdateCommitRequest}) {
+		return nil, nil
+	}
+	info, err := a.Client.Commits.Update(ctx, r.CommitID, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update commit '%s': %w", r.CommitID, err)
 	}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -48,27 +49,14 @@ func HandleConnect(ctx context.Context, a *app.App, r ConnectReq) (presenters.Co
 		if err := a.Client.Vm.UpdateState(ctx, info.VM.VmID, updateParams); err != nil {
 			return view, fmt.Errorf("failed to resume VM: %w", err)
 		}
-		// Wait briefly for the VM to become ready
-		time.Sleep(2 * time.Second)
+		// The VM may still report paused until the resume takes effect
+		if err := waitForBoot(ctx, a, info.VM.VmID, t.Ident, vers.VmStatePaused, vers.VmStateBooting); err != nil {
+			return view, err
+		}
 	case vers.VmStateBooting:
 		fmt.Fprintln(a.IO.Out, "VM is still booting. Waiting...")
-		// Poll until the VM is running
-		for i := 0; i < 30; i++ {
-			time.Sleep(2 * time.Second)
-			refreshed, _, err := utils.GetVmAndNodeIP(ctx, a.Client, info.VM.VmID)
-			if err != nil {
-				return view, fmt.Errorf("failed to check VM status: %w", err)
-			}
-			if refreshed.State == vers.VmStateRunning {
-				info.VM = refreshed
-				break
-			}
-			if refreshed.State == vers.VmStatePaused {
-				return view, fmt.Errorf("VM entered paused state while booting — try 'vers resume %s' first", t.Ident)
-			}
-			if i == 29 {
-				return view, fmt.Errorf("timed out waiting for VM to finish booting")
-			}
+		if err := waitForBoot(ctx, a, info.VM.VmID, t.Ident, vers.VmStateBooting); err != nil {
+			return view, err
 		}
 	default:
 		return view, fmt.Errorf("VM is in '%s' state and cannot be connected to", info.VM.State)
@@ -172,3 +160,25 @@ func HandleConnect(ctx context.Context, a *app.App, r ConnectReq) (presenters.Co
 		}
 	}
 }
+
+// connectBootTimeout bounds how long connect waits for a VM to come up.
+const connectBootTimeout = 60 * time.Second
+
+// waitForBoot waits for a resumed or booting VM to be running, failing if
+// it reaches a state other than the pending ones.
+func waitForBoot(ctx context.Context, a *app.App, vmID, ident string, pending ...vers.VmState) error {
+	ctx, cancel := context.WithTimeout(ctx, connectBootTimeout)
+	defer cancel()
+	err := utils.WaitFor(ctx, utils.StateCondition{
+		Client:  a.Client,
+		VMID:    vmID,
+		Want:    vers.VmStateRunning,
+		Pending: pending,
+	}, utils.DefaultWaitInterval)
+
+	var stateErr *utils.UnexpectedStateError
+	if errors.As(err, &stateErr) && stateErr.State == vers.VmStatePaused {
+		return fmt.Errorf("VM entered paused state while booting — try 'vers resume %s' first", ident)
+	}
+	return err
+}
@@ -19,6 +19,12 @@ type CopyReq struct {
 	Source      string
 	Destination string
 	Recursive   bool
+	// Verify compares SHA-256 digests on both ends after each file.
+	Verify bool
+	// VerifyRetries is how many times a mismatched file is re-transferred.
+	VerifyRetries int
+	// ChecksumFile, if set, receives a sha256sum-compatible manifest.
+	ChecksumFile string
 }
 
 func HandleCopy(ctx context.Context, a *app.App, r CopyReq) (presenters.CopyView, error) {
@@ -82,19 +88,50 @@ func HandleCopy(ctx context.Context, a *app.App, r CopyReq) (presenters.CopyView
 		v.Dest = expandTilde(localPath)
 	}
 
+	opts := sshutil.TransferOptions{
+		Checksums: r.ChecksumFile != "",
+		Verify:    r.Verify,
+		Retries:   r.VerifyRetries,
+	}
+
 	// Use native SFTP client
 	client := sshutil.NewClient(sshHost, info.KeyPath, info.VMDomain)
+	var sums []sshutil.FileChecksum
 	if isUpload {
-		err = client.Upload(ctx, expandTilde(localPath), remotePath, r.Recursive)
+		sums, err = client.UploadWithOptions(ctx, expandTilde(localPath), remotePath, r.Recursive, opts)
 	} else {
-		err = client.Download(ctx, remotePath, expandTilde(localPath), r.Recursive)
+		sums, err = client.DownloadWithOptions(ctx, remotePath, expandTilde(localPath), r.Recursive, opts)
 	}
 	if err != nil {
 		return v, fmt.Errorf("file transfer failed: %w", err)
 	}
+
+	if r.Verify {
+		v.Verified = len(sums)
+	}
+	if r.ChecksumFile != "" {
+		path := expandTilde(r.ChecksumFile)
+		if err := writeChecksumManifest(path, sums); err != nil {
+			return v, err
+		}
+		v.ChecksumFile = path
+	}
 	return v, nil
 }
 
+// writeChecksumManifest writes one "<sha256>  <local-path>" line per file, the
+// format read by `sha256sum -c`.
+func writeChecksumManifest(path string, sums []sshutil.FileChecksum) error {
+	var b strings.Builder
+	for _, s := range sums {
+		fmt.Fprintf(&b, "%s  %s\n", s.SHA256, s.LocalPath)
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write checksum file: %w", err)
+	}
+	return nil
+}
+
 func expandTilde(p string) string {
 	if strings.HasPrefix(p, "~") {
 		if home, err := os.UserHomeDir(); err == nil {
@@ -80,6 +80,10 @@ func HandleDeploy(ctx context.Context, a *app.App, r DeployReq) (presenters.Depl
 
 	// The vers-sdk-go doesn't have a deploy service yet, so we use the
 	// lower-level client.Post() to call the orchestrator endpoint directly.
+	if dryRun(a, presenters.DryRunStep{Call: "POST /api/v1/deploy", Payload: body, Local: []string{"set HEAD to the new VM"}}) {
+		return presenters.DeployView{}, nil
+	}
+
 	var resp deployAPIResponse
 	err := a.Client.Post(ctx, "api/v1/deploy", body, &resp)
 	if err != nil {
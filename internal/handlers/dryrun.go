@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+// dryRun prints steps if a.DryRun is set and reports whether it did. When
+// it returns true the handler must return without calling the API or
+// touching HEAD, aliases or other local state.
+func dryRun(a *app.App, steps ...presenters.DryRunStep) bool {
+	if !a.DryRun {
+		return false
+	}
+	presenters.RenderDryRun(a, steps)
+	return true
+}
+
+// deleteSteps is one call per item for batch deletes.
+func deleteSteps(call string, items []string) []presenters.DryRunStep {
+	steps := make([]presenters.DryRunStep, len(items))
+	for i, item := range items {
+		steps[i] = presenters.DryRunStep{Call: call, Args: []string{item}}
+	}
+	return steps
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	runrt "github.com/hdresearch/vers-cli/internal/runtime"
+)
+
+// EditReq identifies a remote file to edit with the local editor.
+type EditReq struct {
+	Target string
+	Path   string
+	Editor string // overrides $VISUAL / $EDITOR
+	Force  bool   // overwrite without asking if the remote file changed meanwhile
+}
+
+// HandleEdit downloads a remote file to a temp location, opens it in the local
+// editor and uploads it again if it was changed, preserving the file mode.
+func HandleEdit(ctx context.Context, a *app.App, r EditReq) (presenters.EditView, error) {
+	v := presenters.EditView{Path: r.Path}
+	if r.Path == "" {
+		return v, fmt.Errorf("path is required")
+	}
+
+	editor := resolveEditor(a, r.Editor)
+	if len(editor) == 0 {
+		return v, fmt.Errorf("no editor configured: set $EDITOR or pass --editor")
+	}
+
+	client, t, err := fsClient(ctx, a, r.Target)
+	if err != nil {
+		return v, err
+	}
+	v.UsedHEAD = t.UsedHEAD
+	v.HeadID = t.HeadID
+
+	original, mode, err := client.ReadFile(ctx, r.Path)
+	if err != nil {
+		return v, err
+	}
+	originalSum := sha256.Sum256(original)
+
+	// Keep the extension so editors pick the right syntax highlighting
+	tmp, err := os.CreateTemp("", "vers-edit-*-"+path.Base(r.Path))
+	if err != nil {
+		return v, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	keep := false
+	defer func() {
+		if !keep {
+			os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err := tmp.Write(original); err != nil {
+		tmp.Close()
+		return v, fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return v, fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	args := append(editor[1:], tmpPath)
+	if err := a.Runner.Run(ctx, editor[0], args, runrt.Stdio{In: a.IO.In, Out: a.IO.Out, Err: a.IO.Err}); err != nil {
+		keep = true
+		v.LocalCopy = tmpPath
+		return v, fmt.Errorf("editor %s failed: %w", editor[0], err)
+	}
+
+	edited, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return v, fmt.Errorf("failed to read edited file: %w", err)
+	}
+	if sha256.Sum256(edited) == originalSum {
+		return v, nil
+	}
+	v.Changed = true
+
+	// Detect edits made on the VM while the editor was open
+	current, _, err := client.ReadFile(ctx, r.Path)
+	if err != nil {
+		keep = true
+		v.LocalCopy = tmpPath
+		return v, err
+	}
+	if sha256.Sum256(current) != originalSum {
+		v.Conflict = true
+		if !r.Force {
+			presenters.RenderEditConflict(a, v)
+			ok, _ := a.Prompter.YesNo("Overwrite the remote changes")
+			if !ok {
+				keep = true
+				v.LocalCopy = tmpPath
+				return v, fmt.Errorf("operation cancelled by user; your edits are in %s", tmpPath)
+			}
+		}
+	}
+
+	if err := client.WriteFile(ctx, r.Path, edited, mode); err != nil {
+		keep = true
+		v.LocalCopy = tmpPath
+		return v, err
+	}
+	v.Saved = true
+	return v, nil
+}
+
+// resolveEditor returns the editor command split into name and arguments,
+// preferring an explicit override, then $VISUAL, then $EDITOR, then vi.
+func resolveEditor(a *app.App, override string) []string {
+	editor := override
+	if editor == "" && a.Env != nil {
+		editor = a.Env.Get("VISUAL")
+		if editor == "" {
+			editor = a.Env.Get("EDITOR")
+		}
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	return strings.Fields(editor)
+}
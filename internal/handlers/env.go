@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
 	envsvc "github.com/hdresearch/vers-cli/internal/services/env"
 )
 
@@ -37,6 +38,13 @@ func HandleEnvSet(ctx context.Context, a *app.App, req EnvSetReq) error {
 		return fmt.Errorf("environment variable key cannot be empty")
 	}
 
+	if dryRun(a, presenters.DryRunStep{
+		Call:    "PUT /api/v1/env_vars",
+		Payload: envsvc.SetEnvVarsRequest{Vars: map[string]string{req.Key: req.Value}},
+	}) {
+		return nil
+	}
+
 	err := envsvc.SetEnvVar(ctx, a.Client, req.Key, req.Value)
 	if err != nil {
 		return fmt.Errorf("failed to set environment variable: %w", err)
@@ -50,6 +58,10 @@ func HandleEnvDelete(ctx context.Context, a *app.App, req EnvDeleteReq) error {
 		return fmt.Errorf("environment variable key cannot be empty")
 	}
 
+	if dryRun(a, presenters.DryRunStep{Call: "DELETE /api/v1/env_vars/" + req.Key}) {
+		return nil
+	}
+
 	err := envsvc.DeleteEnvVar(ctx, a.Client, req.Key)
 	if err != nil {
 		return fmt.Errorf("failed to delete environment variable: %w", err)
@@ -2,11 +2,18 @@ package handlers
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hdresearch/vers-cli/internal/app"
 	"github.com/hdresearch/vers-cli/internal/presenters"
@@ -14,6 +21,7 @@ import (
 	sshutil "github.com/hdresearch/vers-cli/internal/ssh"
 	"github.com/hdresearch/vers-cli/internal/utils"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
 )
 
 type ExecuteReq struct {
@@ -24,6 +32,38 @@ type ExecuteReq struct {
 	TimeoutSec uint64
 	UseSSH     bool
 	Stdin      string
+
+	// StdinStream, when set, is streamed to the remote command instead of
+	// Stdin. It is uploaded over the exec stream API if the orchestrator
+	// supports it, otherwise over an SSH session.
+	StdinStream io.Reader
+
+	// Capture collects stdout/stderr into the view instead of writing them
+	// to a.IO, keeping at most MaxOutput bytes per stream (0 means no cap).
+	Capture   bool
+	MaxOutput int
+
+	// Script is a local script file, or a directory containing
+	// ScriptEntrypoint, to ship to the VM and run with Command as its
+	// arguments. It is removed from the VM afterwards.
+	Script           string
+	ScriptEntrypoint string
+
+	// Timestamps prefixes output lines with the time they arrived. TeePath
+	// copies stdout and stderr into a file, and SaveLog into a new log
+	// under .vers/logs/exec.
+	Timestamps bool
+	TeePath    string
+	SaveLog    bool
+
+	// TTY runs the command over SSH with a pseudo-terminal, the local
+	// terminal in raw mode and resize forwarding. StdinStream is used as the
+	// terminal input; without it the command gets no input.
+	TTY bool
+
+	// OnEvent, when set, receives every decoded stream event as it arrives
+	// and output is not written to a.IO. Only supported via the API.
+	OnEvent func(presenters.ExecuteEvent)
 }
 
 // streamResponse represents a single NDJSON line from the exec stream.
@@ -38,6 +78,7 @@ type streamResponse struct {
 	DataB64  string `json:"data_b64,omitempty"`
 	ExitCode *int   `json:"exit_code,omitempty"`
 	Cursor   uint64 `json:"cursor,omitempty"`
+	ExecID   string `json:"exec_id,omitempty"`
 	Code     string `json:"code,omitempty"`
 	Message  string `json:"message,omitempty"`
 }
@@ -51,12 +92,70 @@ func HandleExecute(ctx context.Context, a *app.App, r ExecuteReq) (presenters.Ex
 	}
 	v.UsedHEAD = t.UsedHEAD
 	v.HeadID = t.HeadID
+	v.VMID = t.Ident
+
+	if a.Verbose && len(r.Env) > 0 {
+		fmt.Fprintf(a.IO.Err, "[exec] env: %s\n", strings.Join(redactEnv(r.Env), " "))
+	}
+
+	logReq := r
+	if r.Script != "" {
+		if r.Stdin != "" || r.StdinStream != nil || r.TTY {
+			return v, fmt.Errorf("--script cannot be combined with stdin or --tty")
+		}
+		r.Command, r.Stdin, err = scriptCommand(r.Script, r.ScriptEntrypoint, r.Command)
+		if err != nil {
+			return v, err
+		}
+	}
+
+	if r.OnEvent != nil && (r.UseSSH || r.TTY) {
+		return v, fmt.Errorf("streaming events are not supported with --ssh or --tty")
+	}
+	if r.TTY {
+		if r.Capture || r.Timestamps || r.TeePath != "" || r.SaveLog {
+			return v, fmt.Errorf("output cannot be captured or logged with --tty")
+		}
+		return handleExecuteTTY(ctx, a, r, t, v)
+	}
+
+	// Redirect output into capture buffers (or drop it when events are
+	// being emitted) by giving the exec its own view of the app.
+	var stdoutBuf, stderrBuf *cappedBuffer
+	if r.Capture || r.OnEvent != nil {
+		sub := *a
+		if r.Capture {
+			stdoutBuf = &cappedBuffer{limit: r.MaxOutput}
+			stderrBuf = &cappedBuffer{limit: r.MaxOutput}
+			sub.IO = app.Output{In: a.IO.In, Out: stdoutBuf, Err: stderrBuf}
+		} else {
+			sub.IO = app.Output{In: a.IO.In, Out: io.Discard, Err: io.Discard}
+		}
+		a = &sub
+	}
+
+	a, logs, err := openExecLog(a, logReq, t.Ident)
+	if err != nil {
+		return v, err
+	}
 
+	started := time.Now()
 	if r.UseSSH {
-		return handleExecuteSSH(ctx, a, r, t, v)
+		v, err = handleExecuteSSH(ctx, a, r, t, v)
+	} else {
+		v, err = handleExecuteAPI(ctx, a, r, t, v)
+	}
+	v.Duration = time.Since(started)
+	v.LogPath = logs.close(v, err)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		v.TimedOut = true
 	}
 
-	return handleExecuteAPI(ctx, a, r, t, v)
+	if stdoutBuf != nil {
+		v.Stdout, v.StdoutTruncated = stdoutBuf.buf.Bytes(), stdoutBuf.truncated
+		v.Stderr, v.StderrTruncated = stderrBuf.buf.Bytes(), stderrBuf.truncated
+	}
+	return v, err
 }
 
 // handleExecuteAPI runs the command via the orchestrator exec/stream API.
@@ -64,24 +163,39 @@ func handleExecuteAPI(ctx context.Context, a *app.App, r ExecuteReq, t utils.Tar
 	// Wrap the command in bash -c so shell features work
 	command := []string{"bash", "-c", utils.ShellJoin(r.Command)}
 
-	body, err := vmSvc.ExecStream(ctx, t.Ident, vmSvc.ExecRequest{
+	execReq := vmSvc.ExecRequest{
 		Command:    command,
 		Env:        r.Env,
 		WorkingDir: r.WorkingDir,
 		Stdin:      r.Stdin,
 		TimeoutSec: r.TimeoutSec,
-	})
+	}
+
+	var body io.ReadCloser
+	var err error
+	if r.StdinStream != nil {
+		body, err = vmSvc.ExecStreamStdin(ctx, t.Ident, execReq, r.StdinStream)
+		if errors.Is(err, vmSvc.ErrStdinStreamUnsupported) && r.OnEvent == nil {
+			if a.Verbose {
+				fmt.Fprintf(a.IO.Err, "[exec] %v; falling back to SSH\n", err)
+			}
+			return handleExecuteSSH(ctx, a, r, t, v)
+		}
+	} else {
+		body, err = vmSvc.ExecStream(ctx, t.Ident, execReq)
+	}
 	if err != nil {
 		return v, fmt.Errorf("exec: %w", err)
 	}
-	defer body.Close()
 
-	exitCode, err := streamExecOutput(body, a.IO.Out, a.IO.Err)
+	res, err := followExecStream(ctx, a, t.Ident, "", body, r.OnEvent, 0)
+	v.ExecID = res.ExecID
+	v.TimedOut = res.TimedOut
 	if err != nil {
 		return v, fmt.Errorf("exec stream: %w", err)
 	}
 
-	v.ExitCode = exitCode
+	v.ExitCode = res.ExitCode
 	return v, nil
 }
 
@@ -92,11 +206,14 @@ func handleExecuteSSH(ctx context.Context, a *app.App, r ExecuteReq, t utils.Tar
 		return v, fmt.Errorf("failed to get VM information: %w", err)
 	}
 
-	cmdStr := utils.ShellJoin(r.Command)
+	cmdStr := sshCommandString(r)
 	client := sshutil.NewClient(info.Host, info.KeyPath, info.VMDomain)
 
+	if r.StdinStream != nil {
+		return handleExecuteSSHWithStdin(ctx, client, cmdStr, r.StdinStream, a, v)
+	}
 	if r.Stdin != "" {
-		return handleExecuteSSHWithStdin(ctx, client, cmdStr, r.Stdin, a, v)
+		return handleExecuteSSHWithStdin(ctx, client, cmdStr, strings.NewReader(r.Stdin), a, v)
 	}
 
 	err = client.Execute(ctx, cmdStr, a.IO.Out, a.IO.Err)
@@ -110,29 +227,106 @@ func handleExecuteSSH(ctx context.Context, a *app.App, r ExecuteReq, t utils.Tar
 	return v, nil
 }
 
-// handleExecuteSSHWithStdin runs a command via SSH, piping stdin data to the remote process.
-func handleExecuteSSHWithStdin(ctx context.Context, client *sshutil.Client, cmd, stdinData string, a *app.App, v presenters.ExecuteView) (presenters.ExecuteView, error) {
+// handleExecuteTTY runs the command interactively over SSH with a PTY, like
+// HandleConnect does for a login shell, and returns its exit status.
+func handleExecuteTTY(ctx context.Context, a *app.App, r ExecuteReq, t utils.TargetResult, v presenters.ExecuteView) (presenters.ExecuteView, error) {
+	info, err := vmSvc.GetConnectInfo(ctx, a.Client, t.Ident)
+	if err != nil {
+		return v, fmt.Errorf("failed to get VM information: %w", err)
+	}
+
+	cmdStr := sshCommandString(r)
+
+	var stdin io.Reader = strings.NewReader("")
+	if r.StdinStream != nil {
+		stdin = r.StdinStream
+	}
+
+	// Put the local terminal in raw mode so keystrokes go straight to the
+	// remote PTY
+	if f, ok := stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		oldState, err := term.MakeRaw(int(f.Fd()))
+		if err == nil {
+			defer term.Restore(int(f.Fd()), oldState)
+		}
+	}
+
+	client := sshutil.NewClient(info.Host, info.KeyPath, info.VMDomain)
+	started := time.Now()
+	err = client.InteractiveCommand(ctx, cmdStr, stdin, a.IO.Out, a.IO.Err)
+	v.Duration = time.Since(started)
+	if err != nil {
+		if exitErr, ok := err.(*ssh.ExitError); ok {
+			v.ExitCode = exitErr.ExitStatus()
+			return v, nil
+		}
+		return v, fmt.Errorf("failed to execute command: %w", err)
+	}
+	return v, nil
+}
+
+// sshCommandString builds the remote shell command for the SSH paths, which
+// have no separate fields for the environment and working directory. The
+// environment is exported rather than sent with Setenv, since sshd only
+// accepts variables listed in AcceptEnv.
+func sshCommandString(r ExecuteReq) string {
+	var b strings.Builder
+	keys := make([]string, 0, len(r.Env))
+	for k := range r.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b.WriteString("export " + k + "=" + utils.ShellQuote(r.Env[k]) + "; ")
+	}
+	if r.WorkingDir != "" {
+		b.WriteString("cd " + utils.ShellQuote(r.WorkingDir) + " && ")
+	}
+	b.WriteString(utils.ShellJoin(r.Command))
+	return b.String()
+}
+
+// redactEnv lists the variable names in env with their values hidden, for
+// logging. Values may hold secrets and are never printed.
+func redactEnv(env map[string]string) []string {
+	out := make([]string, 0, len(env))
+	for k := range env {
+		out = append(out, k+"=***")
+	}
+	sort.Strings(out)
+	return out
+}
+
+// handleExecuteSSHWithStdin runs a command via SSH, streaming stdin to the
+// remote process. Writes block on the SSH channel window, so the local
+// reader is only drained as fast as the remote side consumes it.
+func handleExecuteSSHWithStdin(ctx context.Context, client *sshutil.Client, cmd string, stdin io.Reader, a *app.App, v presenters.ExecuteView) (presenters.ExecuteView, error) {
 	sess, err := client.StartSession(ctx)
 	if err != nil {
 		return v, fmt.Errorf("failed to start SSH session: %w", err)
 	}
 	defer sess.Close()
 
-	// Copy stdout/stderr in background
-	go io.Copy(a.IO.Out, sess.Stdout())
-	go io.Copy(a.IO.Err, sess.Stderr())
+	// Copy stdout/stderr in background; wait for both before returning so
+	// captured output is complete
+	var copies sync.WaitGroup
+	copies.Add(2)
+	go func() { defer copies.Done(); io.Copy(a.IO.Out, sess.Stdout()) }()
+	go func() { defer copies.Done(); io.Copy(a.IO.Err, sess.Stderr()) }()
 
 	if err := sess.Start(cmd); err != nil {
 		return v, fmt.Errorf("failed to start command: %w", err)
 	}
 
-	// Write stdin data and close to signal EOF
-	if _, err := io.WriteString(sess.Stdin(), stdinData); err != nil {
-		return v, fmt.Errorf("failed to write stdin: %w", err)
-	}
-	sess.Stdin().Close()
+	// Stream stdin and close to signal EOF. This runs in the background so
+	// a command that exits without reading all of its input doesn't hang.
+	go func() {
+		io.Copy(sess.Stdin(), stdin)
+		sess.Stdin().Close()
+	}()
 
 	err = sess.Wait()
+	copies.Wait()
 	if err != nil {
 		if exitErr, ok := err.(*ssh.ExitError); ok {
 			v.ExitCode = exitErr.ExitStatus()
@@ -143,14 +337,25 @@ func handleExecuteSSHWithStdin(ctx context.Context, client *sshutil.Client, cmd,
 	return v, nil
 }
 
-// streamExecOutput reads NDJSON from the exec stream, writes stdout/stderr
-// to the provided writers, and returns the exit code.
-func streamExecOutput(body io.Reader, stdout, stderr io.Writer) (int, error) {
+// execStreamResult is what readExecStream learned from an exec stream.
+type execStreamResult struct {
+	ExitCode int
+	ExecID   string
+	Cursor   uint64
+	TimedOut bool
+	Done     bool // an exit or error event was received
+}
+
+// readExecStream reads NDJSON from the exec stream, writes stdout/stderr to
+// the provided writers and passes each decoded event to onEvent, if set.
+// Events at or before the after cursor were already seen on an earlier
+// connection and are skipped.
+func readExecStream(body io.Reader, stdout, stderr io.Writer, onEvent func(presenters.ExecuteEvent), after uint64) (execStreamResult, error) {
 	scanner := bufio.NewScanner(body)
 	// Allow large lines (agent can send up to 10MB of output)
 	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
 
-	exitCode := 0
+	res := execStreamResult{Cursor: after}
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
@@ -163,6 +368,25 @@ func streamExecOutput(body io.Reader, stdout, stderr io.Writer) (int, error) {
 			// Skip unparseable lines
 			continue
 		}
+		if resp.Cursor != 0 && resp.Cursor <= after {
+			continue
+		}
+		if resp.ExecID != "" {
+			res.ExecID = resp.ExecID
+		}
+		if resp.Cursor > res.Cursor {
+			res.Cursor = resp.Cursor
+		}
+
+		event := presenters.ExecuteEvent{
+			Type:     resp.Type,
+			Stream:   resp.Stream,
+			Cursor:   resp.Cursor,
+			ExecID:   resp.ExecID,
+			ExitCode: resp.ExitCode,
+			Code:     resp.Code,
+			Message:  resp.Message,
+		}
 
 		switch resp.Type {
 		case "chunk":
@@ -176,21 +400,57 @@ func streamExecOutput(body io.Reader, stdout, stderr io.Writer) (int, error) {
 			case "stderr":
 				stderr.Write(data)
 			}
+			if onEvent != nil {
+				event.Data = string(data)
+				onEvent(event)
+			}
 
 		case "exit":
+			res.Done = true
 			if resp.ExitCode != nil {
-				exitCode = *resp.ExitCode
+				res.ExitCode = *resp.ExitCode
+			}
+			if onEvent != nil {
+				onEvent(event)
 			}
-			return exitCode, nil
+			return res, nil
 
 		case "error":
-			return 1, fmt.Errorf("exec error [%s]: %s", resp.Code, resp.Message)
+			if onEvent != nil {
+				onEvent(event)
+			}
+			res.Done = true
+			res.ExitCode = 1
+			res.TimedOut = strings.Contains(strings.ToLower(resp.Code), "timeout")
+			return res, fmt.Errorf("exec error [%s]: %s", resp.Code, resp.Message)
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		return 1, fmt.Errorf("stream read error: %w", err)
+		res.ExitCode = 1
+		return res, fmt.Errorf("stream read error: %w", err)
 	}
 
-	return exitCode, nil
+	return res, nil
+}
+
+// cappedBuffer collects writes up to limit bytes (0 means unlimited) and
+// records whether anything was dropped. Writes never fail so the stream
+// keeps draining after the cap is hit.
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if b.limit > 0 {
+		if room := b.limit - b.buf.Len(); len(p) > room {
+			p = p[:max(room, 0)]
+			b.truncated = true
+		}
+	}
+	b.buf.Write(p)
+	return n, nil
 }
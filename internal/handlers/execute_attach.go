@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vmSvc "github.com/hdresearch/vers-cli/internal/services/vm"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// execResumeAttempts is how many times a dropped exec stream is reattached
+// before giving up.
+const execResumeAttempts = 5
+
+// ExecuteAttachReq reattaches to an exec started earlier.
+type ExecuteAttachReq struct {
+	Target  string // VM ID or alias; HEAD when empty
+	ExecID  string
+	Cursor  uint64 // only replay events after this cursor
+	OnEvent func(presenters.ExecuteEvent)
+}
+
+// HandleExecuteAttach streams the output of an existing exec from the given
+// cursor until it exits, resuming after transient connection errors.
+func HandleExecuteAttach(ctx context.Context, a *app.App, r ExecuteAttachReq) (presenters.ExecuteView, error) {
+	v := presenters.ExecuteView{ExecID: r.ExecID}
+	if r.ExecID == "" {
+		return v, fmt.Errorf("exec ID is required")
+	}
+
+	t, err := utils.ResolveTarget(r.Target)
+	if err != nil {
+		return v, err
+	}
+	v.UsedHEAD = t.UsedHEAD
+	v.HeadID = t.HeadID
+	v.VMID = t.Ident
+
+	if r.OnEvent != nil {
+		sub := *a
+		sub.IO = app.Output{In: a.IO.In, Out: io.Discard, Err: io.Discard}
+		a = &sub
+	}
+
+	body, err := vmSvc.ExecAttach(ctx, t.Ident, r.ExecID, r.Cursor)
+	if err != nil {
+		return v, fmt.Errorf("attach: %w", err)
+	}
+
+	started := time.Now()
+	res, err := followExecStream(ctx, a, t.Ident, r.ExecID, body, r.OnEvent, r.Cursor)
+	v.Duration = time.Since(started)
+	v.TimedOut = res.TimedOut
+	if err != nil {
+		return v, fmt.Errorf("exec stream: %w", err)
+	}
+	v.ExitCode = res.ExitCode
+	return v, nil
+}
+
+// followExecStream reads an exec stream to the end, skipping events at or
+// before cursor. If the connection drops before an exit event, it
+// reattaches to the same exec from the last cursor seen, so output is
+// neither lost nor printed twice. execID may be empty when it is not known
+// yet; it is then taken from the stream.
+func followExecStream(ctx context.Context, a *app.App, vmID, execID string, body io.ReadCloser, onEvent func(presenters.ExecuteEvent), cursor uint64) (execStreamResult, error) {
+	res, err := readExecStream(body, a.IO.Out, a.IO.Err, onEvent, cursor)
+	body.Close()
+	if res.ExecID == "" {
+		res.ExecID = execID
+	}
+
+	for attempt := 1; !res.Done && res.ExecID != ""; attempt++ {
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		if ctx.Err() != nil {
+			return res, ctx.Err()
+		}
+		if attempt > execResumeAttempts {
+			return res, fmt.Errorf("connection lost (resume with: vers exec attach %s %s --cursor %d): %w", vmID, res.ExecID, res.Cursor, err)
+		}
+
+		if a.Verbose {
+			fmt.Fprintf(a.IO.Err, "[exec] stream interrupted, resuming %s from cursor %d (attempt %d/%d)\n", res.ExecID, res.Cursor, attempt, execResumeAttempts)
+		}
+
+		// Back off 1s, 2s, 4s, ... between attempts
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case <-time.After(time.Duration(1<<(attempt-1)) * time.Second):
+		}
+
+		body, attachErr := vmSvc.ExecAttach(ctx, vmID, res.ExecID, res.Cursor)
+		if attachErr != nil {
+			err = attachErr
+			continue
+		}
+
+		execID := res.ExecID
+		res, err = readExecStream(body, a.IO.Out, a.IO.Err, onEvent, res.Cursor)
+		body.Close()
+		if res.ExecID == "" {
+			res.ExecID = execID
+		}
+	}
+
+	return res, err
+}
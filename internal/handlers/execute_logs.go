@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// execLog wraps the output of an exec for --timestamps, --tee and
+// --save-logs. Both output streams are copied into the tee and log files.
+type execLog struct {
+	stdout, stderr *presenters.PrefixWriter
+	files          []*os.File
+	saved          *os.File
+	entry          utils.ExecLog
+}
+
+// openExecLog returns a copy of a whose output goes through the requested
+// timestamping and copies, or a itself if none were requested.
+func openExecLog(a *app.App, r ExecuteReq, vmID string) (*app.App, *execLog, error) {
+	if !r.Timestamps && r.TeePath == "" && !r.SaveLog {
+		return a, nil, nil
+	}
+
+	l := &execLog{}
+	var fileMu, lineMu sync.Mutex
+	outs := []io.Writer{a.IO.Out}
+	errs := []io.Writer{a.IO.Err}
+
+	addFile := func(f *os.File) {
+		l.files = append(l.files, f)
+		w := &lockedWriter{w: f, mu: &fileMu}
+		outs = append(outs, w)
+		errs = append(errs, w)
+	}
+
+	if r.TeePath != "" {
+		f, err := os.Create(r.TeePath)
+		if err != nil {
+			return a, nil, fmt.Errorf("failed to create tee file: %w", err)
+		}
+		addFile(f)
+	}
+
+	if r.SaveLog {
+		l.entry = utils.ExecLog{
+			VMID:      vmID,
+			Command:   utils.ShellJoin(r.Command),
+			StartedAt: a.Clock.Now(),
+		}
+		if r.Script != "" {
+			l.entry.Command = "--script " + utils.ShellJoin(append([]string{r.Script}, r.Command...))
+		}
+		f, err := utils.CreateExecLog(l.entry.StartedAt, targetLabel(vmID))
+		if err != nil {
+			l.close(presenters.ExecuteView{}, nil)
+			return a, nil, err
+		}
+		l.entry.Path = f.Name()
+		if err := utils.WriteExecLogHeader(f, l.entry); err != nil {
+			f.Close()
+			l.close(presenters.ExecuteView{}, nil)
+			return a, nil, fmt.Errorf("failed to write log file: %w", err)
+		}
+		if err := utils.SaveExecLogMeta(l.entry); err != nil {
+			f.Close()
+			l.close(presenters.ExecuteView{}, nil)
+			return a, nil, err
+		}
+		l.saved = f
+		addFile(f)
+	}
+
+	sub := *a
+	sub.IO = app.Output{In: a.IO.In, Out: io.MultiWriter(outs...), Err: io.MultiWriter(errs...)}
+	if r.Timestamps {
+		l.stdout = presenters.NewPrefixWriter(sub.IO.Out, "", &lineMu).WithTimestamps(a.Clock.Now)
+		l.stderr = presenters.NewPrefixWriter(sub.IO.Err, "", &lineMu).WithTimestamps(a.Clock.Now)
+		sub.IO.Out, sub.IO.Err = l.stdout, l.stderr
+	}
+	return &sub, l, nil
+}
+
+// close flushes buffered output, records the outcome in the saved log and
+// closes the files. It returns the path of the saved log, if any.
+func (l *execLog) close(v presenters.ExecuteView, err error) string {
+	if l == nil {
+		return ""
+	}
+	if l.stdout != nil {
+		l.stdout.Flush()
+		l.stderr.Flush()
+	}
+	if l.saved != nil {
+		l.entry.Duration = v.Duration.Round(time.Millisecond)
+		if err != nil {
+			l.entry.Error = err.Error()
+		} else {
+			code := v.ExitCode
+			l.entry.ExitCode = &code
+		}
+		utils.WriteExecLogFooter(l.saved, l.entry)
+		utils.SaveExecLogMeta(l.entry)
+	}
+	for _, f := range l.files {
+		f.Close()
+	}
+	return l.entry.Path
+}
+
+// HandleExecuteHistory lists the logs saved with --save-logs, newest first.
+// limit caps the number of entries; 0 means all.
+func HandleExecuteHistory(a *app.App, limit int) (presenters.ExecuteHistoryView, error) {
+	v := presenters.ExecuteHistoryView{}
+	logs, err := utils.ListExecLogs()
+	if err != nil {
+		return v, err
+	}
+	if limit > 0 && len(logs) > limit {
+		logs = logs[:limit]
+	}
+
+	v.Logs = make([]presenters.ExecuteLogEntry, len(logs))
+	for i, l := range logs {
+		v.Logs[i] = presenters.ExecuteLogEntry{
+			Path:      l.Path,
+			VMID:      l.VMID,
+			Command:   l.Command,
+			StartedAt: l.StartedAt,
+			ExitCode:  l.ExitCode,
+			Duration:  l.Duration,
+			Error:     l.Error,
+		}
+	}
+	return v, nil
+}
+
+// lockedWriter serializes writes from the stdout and stderr copiers into a
+// shared file.
+type lockedWriter struct {
+	w  io.Writer
+	mu *sync.Mutex
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vmSvc "github.com/hdresearch/vers-cli/internal/services/vm"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// ExecuteManyReq runs one command on several VMs at once.
+type ExecuteManyReq struct {
+	Targets     []string // VM IDs or aliases
+	AllChildren string   // VM ID or alias whose branches are added to Targets
+	Selector    string   // label selector whose matches are added to Targets
+	Parallel    int      // maximum concurrent executions; 0 means all at once
+	Exec        ExecuteReq
+	// TargetTimeout, if set, bounds each target's exec on its own, so
+	// targets waiting for a parallel slot don't use up their time.
+	TargetTimeout time.Duration
+}
+
+// HandleExecuteMany runs r.Exec on every target concurrently, prefixing each
+// output line with the target's alias or ID, and returns a per-target summary.
+func HandleExecuteMany(ctx context.Context, a *app.App, r ExecuteManyReq) (presenters.ExecuteManyView, error) {
+	v := presenters.ExecuteManyView{}
+
+	targets := append([]string{}, r.Targets...)
+	if r.AllChildren != "" {
+		lctx, cancel := context.WithTimeout(ctx, a.Timeouts.APIMedium)
+		parent, err := utils.ResolveVMIdentifier(lctx, a.Client, r.AllChildren)
+		if err != nil {
+			cancel()
+			return v, err
+		}
+		children, err := vmSvc.ListChildren(lctx, a.Client, parent.ID)
+		cancel()
+		if err != nil {
+			return v, err
+		}
+		if len(children) == 0 {
+			return v, fmt.Errorf("VM '%s' has no child VMs", r.AllChildren)
+		}
+		targets = append(targets, children...)
+	}
+	if r.Selector != "" {
+		sctx, cancel := context.WithTimeout(ctx, a.Timeouts.APIMedium)
+		ids, err := selectVMIDs(sctx, a, r.Selector)
+		cancel()
+		if err != nil {
+			return v, err
+		}
+		targets = append(targets, ids...)
+	}
+	if len(targets) == 0 {
+		return v, fmt.Errorf("no target VMs")
+	}
+
+	labels := make([]string, len(targets))
+	width := 0
+	for i, t := range targets {
+		labels[i] = targetLabel(t)
+		if len(labels[i]) > width {
+			width = len(labels[i])
+		}
+	}
+
+	parallel := r.Parallel
+	if parallel <= 0 || parallel > len(targets) {
+		parallel = len(targets)
+	}
+
+	v.Results = make([]presenters.ExecuteTargetResult, len(targets))
+	var outMu, errMu sync.Mutex
+
+	// The tee file is shared, so it is opened once here rather than by
+	// each target, and gets the same prefixed lines as the terminal
+	out, errOut := a.IO.Out, a.IO.Err
+	if r.Exec.TeePath != "" {
+		f, err := os.Create(r.Exec.TeePath)
+		if err != nil {
+			return v, fmt.Errorf("failed to create tee file: %w", err)
+		}
+		defer f.Close()
+		var fileMu sync.Mutex
+		out = io.MultiWriter(out, &lockedWriter{w: f, mu: &fileMu})
+		errOut = io.MultiWriter(errOut, &lockedWriter{w: f, mu: &fileMu})
+		r.Exec.TeePath = ""
+	}
+
+	// Commands aren't retried: a failure may have had side effects
+	utils.RunBatch(ctx, targets, utils.BatchOptions{Parallel: parallel}, func(ctx context.Context, i int, target string) (string, error) {
+		prefix := fmt.Sprintf("[%-*s] ", width, labels[i])
+		stdout := presenters.NewPrefixWriter(out, prefix, &outMu)
+		stderr := presenters.NewPrefixWriter(errOut, prefix, &errMu)
+
+		// Each target gets its own view of the app with prefixed output
+		sub := *a
+		sub.IO = app.Output{In: a.IO.In, Out: stdout, Err: stderr}
+
+		req := r.Exec
+		req.Target = target
+		if r.TargetTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, r.TargetTimeout)
+			defer cancel()
+		}
+
+		started := time.Now()
+		res, err := HandleExecute(ctx, &sub, req)
+		stdout.Flush()
+		stderr.Flush()
+
+		result := presenters.ExecuteTargetResult{
+			Target:   labels[i],
+			ExitCode: res.ExitCode,
+			Duration: time.Since(started),
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		v.Results[i] = result
+		return res.VMID, err
+	})
+
+	return v, nil
+}
+
+// targetLabel returns the alias for a VM target if one is known, otherwise
+// the target as given.
+func targetLabel(target string) string {
+	if utils.ResolveAlias(target) != target {
+		return target
+	}
+	if alias := utils.GetAliasByVMID(target); alias != "" {
+		return alias
+	}
+	return target
+}
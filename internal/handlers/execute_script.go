@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// defaultScriptEntrypoint is run when --script points at a directory.
+const defaultScriptEntrypoint = "run.sh"
+
+// scriptCommand builds a command that unpacks a local script (or directory)
+// from stdin into a temp directory on the VM, runs it with the interpreter
+// from its shebang and removes it again. It returns the command and the
+// stdin payload to send with it.
+func scriptCommand(scriptPath, entrypoint string, args []string) ([]string, string, error) {
+	info, err := os.Stat(scriptPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("script: %w", err)
+	}
+
+	root := filepath.Dir(scriptPath)
+	entry := filepath.Base(scriptPath)
+	if info.IsDir() {
+		root = scriptPath
+		entry = entrypoint
+		if entry == "" {
+			entry = defaultScriptEntrypoint
+		}
+	} else if entrypoint != "" {
+		return nil, "", fmt.Errorf("--entrypoint can only be used when --script is a directory")
+	}
+
+	remoteEntry := path.Clean(filepath.ToSlash(entry))
+	if path.IsAbs(remoteEntry) || strings.HasPrefix(remoteEntry, "..") {
+		return nil, "", fmt.Errorf("entrypoint %s must be inside the script directory", entry)
+	}
+
+	interpreter, err := scriptInterpreter(filepath.Join(root, entry))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var archive bytes.Buffer
+	if info.IsDir() {
+		err = writeScriptArchive(&archive, root, "")
+	} else {
+		err = writeScriptArchive(&archive, scriptPath, entry)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to package script: %w", err)
+	}
+
+	wrapper := strings.Join([]string{
+		`d=$(mktemp -d) || exit 1`,
+		`trap 'rm -rf "$d"' EXIT`,
+		`base64 -d | tar -xzmf - -C "$d" || exit 1`,
+		utils.ShellJoin(interpreter) + ` "$d"/` + utils.ShellQuote(remoteEntry) + ` "$@"`,
+	}, "\n")
+
+	command := append([]string{"bash", "-c", wrapper, "vers-script"}, args...)
+	return command, base64.StdEncoding.EncodeToString(archive.Bytes()), nil
+}
+
+// scriptInterpreter returns the interpreter named in the file's shebang line,
+// or bash if it has none.
+func scriptInterpreter(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("script entrypoint: %w", err)
+	}
+	defer f.Close()
+
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("script entrypoint: %w", err)
+	}
+	if !strings.HasPrefix(line, "#!") {
+		return []string{"bash"}, nil
+	}
+	fields := strings.Fields(strings.TrimPrefix(line, "#!"))
+	if len(fields) == 0 {
+		return []string{"bash"}, nil
+	}
+	return fields, nil
+}
+
+// writeScriptArchive writes src as a gzipped tar. A directory is archived
+// with paths relative to it; a single file is stored under name.
+func writeScriptArchive(w io.Writer, src, name string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.Walk(src, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel := name
+		if rel == "" {
+			if rel, err = filepath.Rel(src, p); err != nil {
+				return err
+			}
+			if rel == "." {
+				return nil
+			}
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil // skip symlinks, sockets and the like
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestScriptInterpreter(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		content string
+		want    []string
+	}{
+		{"#!/usr/bin/env python3\nprint('hi')\n", []string{"/usr/bin/env", "python3"}},
+		{"#!/bin/sh -e\necho hi\n", []string{"/bin/sh", "-e"}},
+		{"echo no shebang\n", []string{"bash"}},
+		{"#!", []string{"bash"}},
+	}
+
+	for i, tt := range tests {
+		file := filepath.Join(dir, "script")
+		os.WriteFile(file, []byte(tt.content), 0644)
+		got, err := scriptInterpreter(file)
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %v", i, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("case %d: scriptInterpreter() = %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestScriptCommand(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "run.sh"), []byte("#!/bin/bash\necho \"$@\"\n"), 0755)
+	os.WriteFile(filepath.Join(dir, "other.py"), []byte("#!/usr/bin/env python3\n"), 0644)
+
+	command, stdin, err := scriptCommand(dir, "", []string{"a", "b c"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stdin == "" {
+		t.Error("expected an archive on stdin")
+	}
+	if command[0] != "bash" || command[1] != "-c" || command[3] != "vers-script" {
+		t.Errorf("unexpected command: %q", command)
+	}
+	if !reflect.DeepEqual(command[4:], []string{"a", "b c"}) {
+		t.Errorf("script args = %q", command[4:])
+	}
+	if !strings.Contains(command[2], `/bin/bash "$d"/run.sh "$@"`) {
+		t.Errorf("wrapper does not run the entrypoint:\n%s", command[2])
+	}
+
+	command, _, err = scriptCommand(dir, "other.py", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(command[2], `/usr/bin/env python3 "$d"/other.py "$@"`) {
+		t.Errorf("wrapper does not use the shebang interpreter:\n%s", command[2])
+	}
+
+	if _, _, err := scriptCommand(dir, "../escape.sh", nil); err == nil {
+		t.Error("expected error for entrypoint outside the directory")
+	}
+	if _, _, err := scriptCommand(filepath.Join(dir, "run.sh"), "other.py", nil); err == nil {
+		t.Error("expected error for --entrypoint with a single file")
+	}
+}
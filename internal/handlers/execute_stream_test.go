@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+func TestReadExecStream(t *testing.T) {
+	body := strings.Join([]string{
+		`{"type":"chunk","stream":"stdout","data_b64":"aGVsbG8K","cursor":1,"exec_id":"ex-1"}`,
+		`not json`,
+		`{"type":"chunk","stream":"stderr","data_b64":"b29wcwo=","cursor":2,"exec_id":"ex-1"}`,
+		`{"type":"exit","exit_code":3,"cursor":3,"exec_id":"ex-1"}`,
+	}, "\n")
+
+	var stdout, stderr bytes.Buffer
+	var events []presenters.ExecuteEvent
+	res, err := readExecStream(strings.NewReader(body), &stdout, &stderr, func(e presenters.ExecuteEvent) {
+		events = append(events, e)
+	}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.ExitCode != 3 || res.ExecID != "ex-1" || res.Cursor != 3 {
+		t.Errorf("result = %+v", res)
+	}
+	if stdout.String() != "hello\n" || stderr.String() != "oops\n" {
+		t.Errorf("stdout = %q, stderr = %q", stdout.String(), stderr.String())
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	if events[0].Data != "hello\n" || events[2].Type != "exit" || *events[2].ExitCode != 3 {
+		t.Errorf("unexpected events: %+v", events)
+	}
+}
+
+func TestReadExecStreamTimeout(t *testing.T) {
+	body := `{"type":"error","code":"timeout","message":"command timed out"}`
+
+	var out bytes.Buffer
+	res, err := readExecStream(strings.NewReader(body), &out, &out, nil, 0)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !res.TimedOut || !res.Done || res.ExitCode != 1 {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestReadExecStreamSkipsSeenCursors(t *testing.T) {
+	body := strings.Join([]string{
+		`{"type":"chunk","stream":"stdout","data_b64":"YQ==","cursor":4,"exec_id":"ex-1"}`,
+		`{"type":"chunk","stream":"stdout","data_b64":"Yg==","cursor":5,"exec_id":"ex-1"}`,
+		`{"type":"chunk","stream":"stdout","data_b64":"Yw==","cursor":6,"exec_id":"ex-1"}`,
+	}, "\n")
+
+	var out bytes.Buffer
+	res, err := readExecStream(strings.NewReader(body), &out, &out, nil, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.String() != "c" {
+		t.Errorf("output = %q, want %q", out.String(), "c")
+	}
+	if res.Done || res.Cursor != 6 {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func TestCappedBuffer(t *testing.T) {
+	b := &cappedBuffer{limit: 5}
+	for _, s := range []string{"abc", "defg", "hij"} {
+		n, err := b.Write([]byte(s))
+		if err != nil || n != len(s) {
+			t.Fatalf("Write(%q) = %d, %v", s, n, err)
+		}
+	}
+	if b.buf.String() != "abcde" || !b.truncated {
+		t.Errorf("buf = %q, truncated = %v", b.buf.String(), b.truncated)
+	}
+
+	unlimited := &cappedBuffer{}
+	unlimited.Write([]byte("abcdefgh"))
+	if unlimited.buf.String() != "abcdefgh" || unlimited.truncated {
+		t.Errorf("buf = %q, truncated = %v", unlimited.buf.String(), unlimited.truncated)
+	}
+}
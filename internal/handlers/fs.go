@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vmSvc "github.com/hdresearch/vers-cli/internal/services/vm"
+	sshutil "github.com/hdresearch/vers-cli/internal/ssh"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// FSReq identifies a path on a VM for the remote filesystem commands.
+type FSReq struct {
+	Target    string
+	Path      string
+	Recursive bool // rm -r
+	Parents   bool // mkdir -p
+}
+
+// fsClient resolves the target VM (falling back to HEAD) and returns an SSH
+// client for it, using the same connection path as HandleCopy.
+func fsClient(ctx context.Context, a *app.App, target string) (*sshutil.Client, utils.TargetResult, error) {
+	t, err := utils.ResolveTarget(target)
+	if err != nil {
+		return nil, t, err
+	}
+
+	info, err := vmSvc.GetConnectInfo(ctx, a.Client, t.Ident)
+	if err != nil {
+		return nil, t, fmt.Errorf("failed to get VM information: %w", err)
+	}
+
+	return sshutil.NewClient(info.Host, info.KeyPath, info.VMDomain), t, nil
+}
+
+// HandleLs lists a remote directory (or a single file).
+func HandleLs(ctx context.Context, a *app.App, r FSReq) (presenters.LsView, error) {
+	v := presenters.LsView{Path: r.Path}
+	if v.Path == "" {
+		v.Path = "."
+	}
+
+	client, t, err := fsClient(ctx, a, r.Target)
+	if err != nil {
+		return v, err
+	}
+	v.UsedHEAD = t.UsedHEAD
+	v.HeadID = t.HeadID
+
+	infos, isDir, err := client.List(ctx, v.Path)
+	if err != nil {
+		return v, err
+	}
+
+	v.Entries = make([]presenters.FileEntry, len(infos))
+	for i, info := range infos {
+		p := v.Path
+		if isDir {
+			p = path.Join(v.Path, info.Name())
+		}
+		v.Entries[i] = presenters.NewFileEntry(p, info)
+	}
+	return v, nil
+}
+
+// HandleStat returns file information for a remote path.
+func HandleStat(ctx context.Context, a *app.App, r FSReq) (presenters.StatView, error) {
+	v := presenters.StatView{}
+	if r.Path == "" {
+		return v, fmt.Errorf("path is required")
+	}
+
+	client, t, err := fsClient(ctx, a, r.Target)
+	if err != nil {
+		return v, err
+	}
+	v.UsedHEAD = t.UsedHEAD
+	v.HeadID = t.HeadID
+
+	info, err := client.Stat(ctx, r.Path)
+	if err != nil {
+		return v, err
+	}
+	v.Entry = presenters.NewFileEntry(r.Path, info)
+	return v, nil
+}
+
+// HandleCat writes the contents of a remote file to a.IO.Out.
+func HandleCat(ctx context.Context, a *app.App, r FSReq) error {
+	if r.Path == "" {
+		return fmt.Errorf("path is required")
+	}
+
+	client, _, err := fsClient(ctx, a, r.Target)
+	if err != nil {
+		return err
+	}
+	return client.Cat(ctx, r.Path, a.IO.Out)
+}
+
+// HandleRm removes a remote file, or a directory tree when Recursive is set.
+func HandleRm(ctx context.Context, a *app.App, r FSReq) (presenters.FSOpView, error) {
+	v := presenters.FSOpView{Path: r.Path}
+	if r.Path == "" {
+		return v, fmt.Errorf("path is required")
+	}
+	if path.Clean(r.Path) == "/" {
+		return v, fmt.Errorf("refusing to remove '/'")
+	}
+
+	client, t, err := fsClient(ctx, a, r.Target)
+	if err != nil {
+		return v, err
+	}
+	v.UsedHEAD = t.UsedHEAD
+	v.HeadID = t.HeadID
+
+	return v, client.Remove(ctx, r.Path, r.Recursive)
+}
+
+// HandleMkdir creates a remote directory, and its parents when Parents is set.
+func HandleMkdir(ctx context.Context, a *app.App, r FSReq) (presenters.FSOpView, error) {
+	v := presenters.FSOpView{Path: r.Path}
+	if r.Path == "" {
+		return v, fmt.Errorf("path is required")
+	}
+
+	client, t, err := fsClient(ctx, a, r.Target)
+	if err != nil {
+		return v, err
+	}
+	v.UsedHEAD = t.UsedHEAD
+	v.HeadID = t.HeadID
+
+	return v, client.Mkdir(ctx, r.Path, r.Parents)
+}
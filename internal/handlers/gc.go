@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	delsvc "github.com/hdresearch/vers-cli/internal/services/deletion"
+	svc "github.com/hdresearch/vers-cli/internal/services/status"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+// Actions `vers gc` can take on the VMs it selects.
+const (
+	GCActionPause         = "pause"
+	GCActionKill          = "kill"
+	GCActionCommitAndKill = "commit-and-kill"
+)
+
+type GCReq struct {
+	OlderThan      time.Duration
+	States         []string // VM states to consider; empty means running and paused
+	ExcludeLabels  []string // label selectors; matching VMs are skipped
+	Action         string
+	IncludeHead    bool
+	IncludeAliased bool
+	Yes            bool // act without asking; structured output only shows the plan otherwise
+	Batch          BatchOpts
+}
+
+// HandleGC finds VMs older than r.OlderThan and pauses or deletes them.
+// HEAD, aliased and protected VMs are skipped unless asked for; protected
+// VMs always are. The plan is printed before anything is done.
+func HandleGC(ctx context.Context, a *app.App, r GCReq) (presenters.GCReport, error) {
+	switch r.Action {
+	case GCActionPause, GCActionKill, GCActionCommitAndKill:
+	default:
+		return presenters.GCReport{}, fmt.Errorf("invalid --action %q: expected pause, kill or commit-and-kill", r.Action)
+	}
+	if r.OlderThan <= 0 {
+		return presenters.GCReport{}, fmt.Errorf("--older-than must be positive")
+	}
+	if len(r.States) == 0 {
+		r.States = []string{string(vers.VmStateRunning), string(vers.VmStatePaused)}
+	}
+
+	f := gcFilter{
+		action:         r.Action,
+		now:            a.Clock.Now(),
+		olderThan:      r.OlderThan,
+		states:         map[string]bool{},
+		includeHead:    r.IncludeHead,
+		includeAliased: r.IncludeAliased,
+	}
+	for _, s := range r.States {
+		f.states[strings.ToLower(strings.TrimSpace(s))] = true
+	}
+	for _, s := range r.ExcludeLabels {
+		sel, err := utils.ParseLabelSelector(s)
+		if err != nil {
+			return presenters.GCReport{}, err
+		}
+		f.exclude = append(f.exclude, sel)
+	}
+	var err error
+	if f.labels, err = utils.LoadLabels(); err != nil {
+		return presenters.GCReport{}, err
+	}
+	if f.protected, err = utils.LoadProtected(); err != nil {
+		return presenters.GCReport{}, err
+	}
+	f.aliases = map[string]string{}
+	if aliases, err := utils.LoadAliases(); err == nil {
+		for alias, id := range aliases {
+			f.aliases[id] = alias
+		}
+	}
+	f.head, _ = utils.GetCurrentHeadVM()
+
+	lctx, cancel := context.WithTimeout(ctx, a.Timeouts.APIMedium)
+	vms, err := svc.ListVMs(lctx, a.Client)
+	cancel()
+	if err != nil {
+		return presenters.GCReport{}, err
+	}
+
+	report := presenters.GCReport{
+		Action:    r.Action,
+		OlderThan: r.OlderThan.String(),
+		States:    r.States,
+	}
+	report.Planned, report.Skipped = f.plan(vms)
+
+	structured := r.Batch.Format.Structured()
+	if !structured {
+		presenters.RenderGCPlan(a, report)
+	}
+	if a.DryRun && !structured {
+		presenters.RenderDryRun(a, gcSteps(r.Action, report.Planned))
+	}
+	// Without --yes there is nobody to ask when printing JSON, e.g. from
+	// cron, so only the plan is reported
+	if len(report.Planned) == 0 || a.DryRun || (structured && !r.Yes) {
+		if structured {
+			return report, presenters.PrintGCReport(report, r.Batch.Format)
+		}
+		return report, nil
+	}
+
+	if !r.Yes {
+		ok, _ := a.Prompter.YesNo("Proceed")
+		if !ok {
+			presenters.OperationCancelled()
+			return report, fmt.Errorf("operation cancelled by user")
+		}
+	}
+
+	ids := make([]string, len(report.Planned))
+	for i, it := range report.Planned {
+		ids[i] = it.VmID
+	}
+	results := utils.RunBatch(ctx, ids, utils.BatchOptions{
+		Parallel: r.Batch.Parallel,
+		Retries:  r.Batch.Retries,
+		OnResult: func(done int, res utils.BatchResult) {
+			it := &report.Planned[res.Index]
+			it.OK = res.Err == nil
+			if res.Err != nil {
+				it.Error = res.Err.Error()
+			}
+			if !structured {
+				presenters.GCItemResult(a, done, len(ids), r.Action, *it)
+			}
+		},
+	}, func(ctx context.Context, i int, id string) (string, error) {
+		// Each VM gets its own deadline, so a long batch doesn't run out
+		ctx, cancel := context.WithTimeout(ctx, a.Timeouts.APILong)
+		defer cancel()
+		return gcApply(ctx, a, r.Action, &report.Planned[i])
+	})
+
+	report.Executed = true
+	var deleted []string
+	for _, res := range results {
+		if res.Err != nil {
+			report.Failed++
+			continue
+		}
+		report.Succeeded++
+		if r.Action != GCActionPause {
+			deleted = append(deleted, res.ID)
+		}
+	}
+	if len(deleted) > 0 {
+		if utils.CleanupAfterDeletion(deleted) && !structured {
+			fmt.Println("HEAD cleared (VM was deleted)")
+		}
+		utils.RemoveLabelsForVMs(deleted)
+	}
+
+	if structured {
+		if err := presenters.PrintGCReport(report, r.Batch.Format); err != nil {
+			return report, err
+		}
+	} else {
+		presenters.RenderGCSummary(a, report)
+	}
+	if report.Failed > 0 {
+		return report, fmt.Errorf("gc: %d of %d VMs failed", report.Failed, len(ids))
+	}
+	return report, nil
+}
+
+// gcApply takes the gc action on one VM, recording a safety commit in it.
+func gcApply(ctx context.Context, a *app.App, action string, it *presenters.GCItem) (string, error) {
+	switch action {
+	case GCActionPause:
+		_, err := HandlePause(ctx, a, PauseReq{Target: it.VmID})
+		return it.VmID, err
+	case GCActionCommitAndKill:
+		// A retried delete already has its commit; don't take another
+		if it.CommitID == "" {
+			resp, err := a.Client.Vm.Commit(ctx, it.VmID, vers.VmCommitParams{})
+			if err != nil {
+				return "", fmt.Errorf("commit failed, VM not deleted: %w", err)
+			}
+			it.CommitID = resp.CommitID
+		}
+	}
+	return delsvc.DeleteVM(ctx, a.Client, it.VmID)
+}
+
+// gcSteps lists the calls HandleGC would make for --dry-run.
+func gcSteps(action string, planned []presenters.GCItem) []presenters.DryRunStep {
+	var steps []presenters.DryRunStep
+	for _, it := range planned {
+		switch action {
+		case GCActionPause:
+			steps = append(steps, presenters.DryRunStep{Call: "Vm.UpdateState", Args: []string{it.VmID}, Payload: map[string]string{"state": "Paused"}})
+			continue
+		case GCActionCommitAndKill:
+			steps = append(steps, presenters.DryRunStep{Call: "Vm.Commit", Args: []string{it.VmID}})
+		}
+		steps = append(steps, presenters.DryRunStep{Call: "Vm.Delete", Args: []string{it.VmID}})
+	}
+	return steps
+}
+
+// gcFilter decides which VMs `vers gc` acts on, from its flags and local
+// state.
+type gcFilter struct {
+	action    string
+	now       time.Time
+	olderThan time.Duration
+	states    map[string]bool
+	exclude   []utils.LabelSelector
+
+	head      string
+	aliases   map[string]string // VM ID to alias
+	labels    map[string]map[string]string
+	protected map[string]bool
+
+	includeHead, includeAliased bool
+}
+
+// plan returns the VMs old enough and in a selected state, oldest first,
+// split into those to act on and those skipped with a reason. VMs that
+// are already paused are left out when the action is pause.
+func (f gcFilter) plan(vms []vers.Vm) (planned, skipped []presenters.GCItem) {
+	planned, skipped = []presenters.GCItem{}, []presenters.GCItem{}
+	sorted := append([]vers.Vm(nil), vms...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt.Before(sorted[j].CreatedAt) })
+
+	for _, vm := range sorted {
+		age := f.now.Sub(vm.CreatedAt)
+		if age < f.olderThan || !f.states[strings.ToLower(string(vm.State))] {
+			continue
+		}
+		if f.action == GCActionPause && vm.State == vers.VmStatePaused {
+			continue
+		}
+		it := presenters.GCItem{
+			VmID:      vm.VmID,
+			Alias:     f.aliases[vm.VmID],
+			State:     string(vm.State),
+			CreatedAt: vm.CreatedAt,
+			Age:       presenters.FormatAge(age),
+		}
+		switch {
+		case f.protected[vm.VmID]:
+			it.Reason = "protected"
+		case vm.VmID == f.head && !f.includeHead:
+			it.Reason = "HEAD (use --include-head)"
+		case it.Alias != "" && !f.includeAliased:
+			it.Reason = fmt.Sprintf("has alias '%s' (use --include-aliased)", it.Alias)
+		default:
+			for _, sel := range f.exclude {
+				if sel.Matches(f.labels[vm.VmID]) {
+					it.Reason = fmt.Sprintf("matches --exclude-label %s", sel)
+					break
+				}
+			}
+		}
+		if it.Reason != "" {
+			skipped = append(skipped, it)
+		} else {
+			planned = append(planned, it)
+		}
+	}
+	return planned, skipped
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+func TestGCFilterPlan(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	keep, err := utils.ParseLabelSelector("keep=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := gcFilter{
+		action:    GCActionKill,
+		now:       now,
+		olderThan: 24 * time.Hour,
+		states:    map[string]bool{"running": true, "paused": true},
+		exclude:   []utils.LabelSelector{keep},
+		head:      "head",
+		aliases:   map[string]string{"aliased": "db"},
+		labels:    map[string]map[string]string{"kept": {"keep": "true"}},
+		protected: map[string]bool{"locked": true},
+	}
+	vms := []vers.Vm{
+		{VmID: "new", State: vers.VmStateRunning, CreatedAt: now.Add(-time.Hour)},
+		{VmID: "old", State: vers.VmStateRunning, CreatedAt: now.Add(-48 * time.Hour)},
+		{VmID: "older", State: vers.VmStatePaused, CreatedAt: now.Add(-72 * time.Hour)},
+		{VmID: "booting", State: vers.VmStateBooting, CreatedAt: now.Add(-72 * time.Hour)},
+		{VmID: "head", State: vers.VmStateRunning, CreatedAt: now.Add(-48 * time.Hour)},
+		{VmID: "aliased", State: vers.VmStateRunning, CreatedAt: now.Add(-48 * time.Hour)},
+		{VmID: "kept", State: vers.VmStateRunning, CreatedAt: now.Add(-48 * time.Hour)},
+		{VmID: "locked", State: vers.VmStateRunning, CreatedAt: now.Add(-48 * time.Hour)},
+	}
+
+	planned, skipped := f.plan(vms)
+	if len(planned) != 2 || planned[0].VmID != "older" || planned[1].VmID != "old" {
+		t.Fatalf("expected older and old, oldest first, got %+v", planned)
+	}
+	if planned[0].Age != "3d0h" {
+		t.Errorf("unexpected age %q", planned[0].Age)
+	}
+	reasons := map[string]string{}
+	for _, it := range skipped {
+		reasons[it.VmID] = it.Reason
+	}
+	if len(reasons) != 4 || reasons["locked"] != "protected" || reasons["head"] == "" || reasons["aliased"] == "" || reasons["kept"] == "" {
+		t.Errorf("unexpected skipped VMs: %v", reasons)
+	}
+
+	f.includeHead, f.includeAliased = true, true
+	planned, skipped = f.plan(vms)
+	if len(planned) != 4 || len(skipped) != 2 {
+		t.Errorf("expected HEAD and aliased VMs planned, got %d planned, %d skipped", len(planned), len(skipped))
+	}
+
+	// Pausing a paused VM is pointless, so it isn't planned
+	f.action = GCActionPause
+	planned, _ = f.plan(vms)
+	for _, it := range planned {
+		if it.VmID == "older" {
+			t.Errorf("paused VM planned for pausing: %+v", planned)
+		}
+	}
+	if len(planned) != 3 {
+		t.Errorf("expected 3 running VMs planned for pausing, got %+v", planned)
+	}
+}
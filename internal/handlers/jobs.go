@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vmSvc "github.com/hdresearch/vers-cli/internal/services/vm"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// jobsRemoteRoot holds one directory per detached job on the VM, containing
+// its pid, combined output log and, once finished, its exit code.
+const jobsRemoteRoot = "/tmp/vers-jobs"
+
+// JobLogsReq selects the output of a detached job.
+type JobLogsReq struct {
+	ID     string
+	Follow bool // keep streaming until the job exits
+}
+
+// JobKillReq signals a detached job.
+type JobKillReq struct {
+	ID     string
+	Signal string // signal name, TERM when empty
+}
+
+// HandleJobStart starts r.Command in the background on the VM with nohup,
+// detached from the exec session, and records the job locally.
+func HandleJobStart(ctx context.Context, a *app.App, r ExecuteReq) (presenters.JobStartView, error) {
+	v := presenters.JobStartView{}
+	if r.Stdin != "" || r.StdinStream != nil {
+		return v, fmt.Errorf("stdin cannot be passed to a detached job")
+	}
+	if r.Script != "" {
+		return v, fmt.Errorf("--script cannot be used with --detach")
+	}
+
+	t, err := utils.ResolveTarget(r.Target)
+	if err != nil {
+		return v, err
+	}
+	v.UsedHEAD = t.UsedHEAD
+	v.HeadID = t.HeadID
+
+	id, err := utils.NewJobID()
+	if err != nil {
+		return v, err
+	}
+	dir := path.Join(jobsRemoteRoot, id)
+	q := utils.ShellQuote
+
+	// setsid puts the job in its own process group so it survives the exec
+	// session and can be signalled as a whole
+	inner := "bash -c " + q(utils.ShellJoin(r.Command)) + "; echo $? > " + q(dir+"/exit")
+	script := strings.Join([]string{
+		"mkdir -p " + q(dir) + " || exit 1",
+		"setsid nohup bash -c " + q(inner) + " > " + q(dir+"/log") + " 2>&1 < /dev/null &",
+		"echo $! > " + q(dir+"/pid"),
+		"echo $!",
+	}, "\n")
+
+	out, err := runJobScript(ctx, t.Ident, script, r.Env, r.WorkingDir)
+	if err != nil {
+		return v, fmt.Errorf("failed to start job: %w", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(out))
+	if err != nil {
+		return v, fmt.Errorf("failed to start job: unexpected output %q", out)
+	}
+
+	job := utils.Job{
+		ID:         id,
+		VMID:       t.Ident,
+		Command:    r.Command,
+		WorkingDir: r.WorkingDir,
+		StartedAt:  a.Clock.Now(),
+		PID:        pid,
+		RemoteDir:  dir,
+		LogPath:    dir + "/log",
+	}
+	if err := utils.SaveJob(job); err != nil {
+		return v, err
+	}
+
+	v.Job = jobStatus(job)
+	v.Job.State = presenters.JobRunning
+	return v, nil
+}
+
+// HandleJobList returns all locally recorded jobs with their state on the VM.
+func HandleJobList(ctx context.Context, a *app.App) (presenters.JobListView, error) {
+	v := presenters.JobListView{}
+	jobs, err := utils.ListJobs()
+	if err != nil {
+		return v, err
+	}
+
+	for _, job := range jobs {
+		s := jobStatus(job)
+		out, err := runJobScript(ctx, job.VMID, jobStateScript(job), nil, "")
+		if err != nil {
+			s.State = presenters.JobUnknown
+		} else {
+			s.State, s.ExitCode = parseJobState(out)
+		}
+		v.Jobs = append(v.Jobs, s)
+	}
+	return v, nil
+}
+
+// HandleJobLogs writes the job's output log to a.IO.Out. With Follow set it
+// keeps streaming new output until the job exits.
+func HandleJobLogs(ctx context.Context, a *app.App, r JobLogsReq) error {
+	job, err := utils.LoadJob(r.ID)
+	if err != nil {
+		return err
+	}
+
+	cmd := "tail -n +1 " + utils.ShellQuote(job.LogPath)
+	if r.Follow {
+		cmd = fmt.Sprintf("tail -n +1 -f --pid=%d %s", job.PID, utils.ShellQuote(job.LogPath))
+	}
+
+	body, err := vmSvc.ExecStream(ctx, job.VMID, vmSvc.ExecRequest{Command: []string{"bash", "-c", cmd}})
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+	res, err := followExecStream(ctx, a, job.VMID, "", body, nil, 0)
+	if err != nil {
+		return fmt.Errorf("exec stream: %w", err)
+	}
+	if res.ExitCode != 0 {
+		return fmt.Errorf("failed to read log of job %s (exit code %d)", job.ID, res.ExitCode)
+	}
+	return nil
+}
+
+// HandleJobWait blocks until the job exits and returns its exit code.
+func HandleJobWait(ctx context.Context, a *app.App, id string) (presenters.JobWaitView, error) {
+	v := presenters.JobWaitView{ID: id}
+	job, err := utils.LoadJob(id)
+	if err != nil {
+		return v, err
+	}
+	v.ID = job.ID
+
+	// Poll on the VM so a single stream covers the whole wait
+	script := fmt.Sprintf("while [ ! -f %s ] && kill -0 %d 2>/dev/null; do sleep 1; done\n%s",
+		utils.ShellQuote(job.RemoteDir+"/exit"), job.PID, jobStateScript(job))
+
+	body, err := vmSvc.ExecStream(ctx, job.VMID, vmSvc.ExecRequest{Command: []string{"bash", "-c", script}})
+	if err != nil {
+		return v, fmt.Errorf("exec: %w", err)
+	}
+
+	var out bytes.Buffer
+	sub := *a
+	sub.IO = app.Output{In: a.IO.In, Out: &out, Err: a.IO.Err}
+	if _, err := followExecStream(ctx, &sub, job.VMID, "", body, nil, 0); err != nil {
+		return v, fmt.Errorf("exec stream: %w", err)
+	}
+
+	state, exitCode := parseJobState(out.String())
+	v.State = state
+	switch {
+	case exitCode != nil:
+		v.ExitCode = *exitCode
+	case state == presenters.JobStopped:
+		v.ExitCode = 1
+	}
+	return v, nil
+}
+
+// HandleJobKill sends a signal to the job's process group.
+func HandleJobKill(ctx context.Context, a *app.App, r JobKillReq) (presenters.JobStatus, error) {
+	job, err := utils.LoadJob(r.ID)
+	if err != nil {
+		return presenters.JobStatus{}, err
+	}
+	s := jobStatus(job)
+
+	signal := r.Signal
+	if signal == "" {
+		signal = "TERM"
+	}
+
+	script := fmt.Sprintf("kill -s %s -- -%d", utils.ShellQuote(strings.TrimPrefix(strings.ToUpper(signal), "SIG")), job.PID)
+	if _, err := runJobScript(ctx, job.VMID, script, nil, ""); err != nil {
+		return s, fmt.Errorf("failed to kill job %s: %w", job.ID, err)
+	}
+	return s, nil
+}
+
+// runJobScript runs a bash script on the VM through the exec API and
+// returns its stdout, failing if the script exits non-zero.
+func runJobScript(ctx context.Context, vmID, script string, env map[string]string, workDir string) (string, error) {
+	resp, err := vmSvc.Exec(ctx, vmID, vmSvc.ExecRequest{
+		Command:    []string{"bash", "-c", script},
+		Env:        env,
+		WorkingDir: workDir,
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.ExitCode != 0 {
+		return "", fmt.Errorf("exit code %d: %s", resp.ExitCode, strings.TrimSpace(resp.Stderr))
+	}
+	return resp.Stdout, nil
+}
+
+// jobStateScript prints "exited <code>", "running" or "stopped".
+func jobStateScript(job utils.Job) string {
+	exitFile := utils.ShellQuote(job.RemoteDir + "/exit")
+	return fmt.Sprintf(`if [ -f %s ]; then echo exited "$(cat %s)"; elif kill -0 %d 2>/dev/null; then echo running; else echo stopped; fi`,
+		exitFile, exitFile, job.PID)
+}
+
+// parseJobState parses the output of jobStateScript.
+func parseJobState(out string) (string, *int) {
+	fields := strings.Fields(out)
+	if len(fields) == 0 {
+		return presenters.JobUnknown, nil
+	}
+	switch fields[0] {
+	case presenters.JobExited:
+		if len(fields) > 1 {
+			if code, err := strconv.Atoi(fields[1]); err == nil {
+				return presenters.JobExited, &code
+			}
+		}
+		return presenters.JobExited, nil
+	case presenters.JobRunning, presenters.JobStopped:
+		return fields[0], nil
+	}
+	return presenters.JobUnknown, nil
+}
+
+func jobStatus(job utils.Job) presenters.JobStatus {
+	return presenters.JobStatus{
+		ID:        job.ID,
+		VMID:      job.VMID,
+		Command:   utils.ShellJoin(job.Command),
+		StartedAt: job.StartedAt,
+		PID:       job.PID,
+		LogPath:   job.LogPath,
+	}
+}
@@ -3,21 +3,62 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/errorsx"
 	"github.com/hdresearch/vers-cli/internal/presenters"
 	delsvc "github.com/hdresearch/vers-cli/internal/services/deletion"
 	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
 )
 
+// defaultGraceTimeout bounds KillReq.Graceful when no timeout is given.
+const defaultGraceTimeout = 30 * time.Second
+
 type KillReq struct {
 	Targets          []string
+	Selector         string // label selector; replaces Targets
 	SkipConfirmation bool
+	Batch            BatchOpts
+
+	// Force allows deleting VMs locked with `vers protect`, after the user
+	// types each one's ID. -y does not skip that prompt.
+	Force bool
+
+	// Commit snapshots each VM with Vm.Commit before deleting it. A VM
+	// whose commit fails is not deleted.
+	Commit bool
+	// Tag names the safety commit, moving the tag if it exists. {alias}
+	// and {id} are replaced with each VM's alias (or ID) and ID. Implies
+	// Commit.
+	Tag string
+	// Graceful is a shell command run on each VM before the commit and
+	// delete, e.g. to stop services cleanly. Failures only warn.
+	Graceful     string
+	GraceTimeout time.Duration
 }
 
+// HandleKill deletes VMs. Each VM gets its own deadline, long enough for
+// the graceful shutdown command, the safety commit and the delete, so ctx
+// itself should not carry a short one.
 func HandleKill(ctx context.Context, a *app.App, r KillReq) error {
 	targets := r.Targets
 
+	if r.Selector != "" {
+		if len(targets) > 0 {
+			return fmt.Errorf("cannot use VM arguments together with -l")
+		}
+		sctx, cancel := context.WithTimeout(ctx, a.Timeouts.APIMedium)
+		ids, err := selectVMIDs(sctx, a, r.Selector)
+		cancel()
+		if err != nil {
+			return err
+		}
+		targets = ids
+	}
+
 	// Default to HEAD if no targets
 	if len(targets) == 0 {
 		t, err := utils.ResolveTarget("")
@@ -27,6 +68,30 @@ func HandleKill(ctx context.Context, a *app.App, r KillReq) error {
 		targets = []string{t.Ident}
 	}
 
+	if r.Tag != "" {
+		r.Commit = true
+		if len(targets) > 1 && !strings.Contains(r.Tag, "{alias}") && !strings.Contains(r.Tag, "{id}") {
+			return fmt.Errorf("--tag must contain {alias} or {id} when deleting more than one VM")
+		}
+	}
+	if r.GraceTimeout <= 0 {
+		r.GraceTimeout = defaultGraceTimeout
+	}
+
+	protected, err := utils.ProtectedTargets(targets)
+	if err != nil {
+		return err
+	}
+	if len(protected) > 0 && !r.Force {
+		return protectedError(protected, "pass --force to delete anyway")
+	}
+
+	if a.DryRun {
+		dctx, cancel := context.WithTimeout(ctx, a.Timeouts.APILong)
+		defer cancel()
+		return dryRunKill(dctx, a, r, targets)
+	}
+
 	// Confirm if needed
 	if !r.SkipConfirmation {
 		for _, t := range targets {
@@ -38,48 +103,148 @@ func HandleKill(ctx context.Context, a *app.App, r KillReq) error {
 			return fmt.Errorf("operation cancelled by user")
 		}
 	}
+	for _, id := range protected {
+		ok, _ := a.Prompter.ConfirmExact(fmt.Sprintf("VM '%s' is protected. To delete it anyway", id), id)
+		if !ok {
+			presenters.OperationCancelled()
+			return fmt.Errorf("operation cancelled by user")
+		}
+	}
 
-	var firstErr error
-	var allDeleted []string
-
-	for i, target := range targets {
-		// Resolve alias → ID
+	r.Batch.ItemTimeout = a.Timeouts.APILong
+	if r.Graceful != "" {
+		r.Batch.ItemTimeout += r.GraceTimeout
+	}
+	results, err := runBatch(ctx, a, targets, r.Batch, "VMs", "VM", func(ctx context.Context, target string, item *presenters.SummaryItem) (string, error) {
 		vmInfo, err := utils.ResolveVMIdentifier(ctx, a.Client, target)
 		if err != nil {
-			fmt.Fprintf(a.IO.Err, "✗ Failed to resolve '%s': %v\n", target, err)
-			if firstErr == nil {
-				firstErr = err
-			}
-			continue
+			return "", err
 		}
-
-		presenters.ProgressCounter(i+1, len(targets), "Deleting VM", vmInfo.DisplayName)
-
-		deletedID, err := delsvc.DeleteVM(ctx, a.Client, vmInfo.ID)
-		if err != nil {
-			fmt.Fprintf(a.IO.Err, "✗ Failed to delete '%s': %v\n", vmInfo.DisplayName, err)
-			if firstErr == nil {
-				firstErr = err
-			}
-			continue
+		if err := prepareKill(ctx, a, r, vmInfo, item); err != nil {
+			return "", err
 		}
+		return delsvc.DeleteVM(ctx, a.Client, vmInfo.ID)
+	})
 
-		presenters.SuccessMessage(fmt.Sprintf("Deleted VM '%s'", vmInfo.DisplayName))
-		allDeleted = append(allDeleted, deletedID)
+	var allDeleted []string
+	for _, res := range results {
+		if res.Err == nil {
+			allDeleted = append(allDeleted, res.ID)
+		}
 	}
 
 	// Clean up HEAD if any deleted VM was HEAD
-	if len(allDeleted) > 0 && utils.CleanupAfterDeletion(allDeleted) {
+	if len(allDeleted) > 0 && utils.CleanupAfterDeletion(allDeleted) && !r.Batch.Format.Structured() {
 		fmt.Println("HEAD cleared (VM was deleted)")
 	}
+	if len(allDeleted) > 0 {
+		utils.RemoveLabelsForVMs(allDeleted)
+		utils.RemoveProtectionForVMs(allDeleted)
+	}
+	if r.Commit && len(allDeleted) > 0 && !r.Batch.Format.Structured() {
+		fmt.Println("Restore a deleted VM with 'vers run-commit <commit-id|tag>'")
+	}
+
+	return err
+}
 
-	if len(targets) > 1 {
-		presenters.PrintDeletionSummary(presenters.SummaryResults{
-			SuccessCount: len(allDeleted),
-			FailCount:    len(targets) - len(allDeleted),
-			ItemType:     "VMs",
+// protectedError reports the protected VMs a delete would have touched.
+func protectedError(ids []string, hint string) error {
+	return fmt.Errorf("%w: %s (run 'vers unprotect <vm>' or %s)", utils.ErrVMProtected, strings.Join(ids, ", "), hint)
+}
+
+// prepareKill runs the graceful shutdown command on a VM and takes its
+// safety commit, recording both in item so a retried delete repeats
+// neither.
+func prepareKill(ctx context.Context, a *app.App, r KillReq, vm *utils.VMInfo, item *presenters.SummaryItem) error {
+	if r.Graceful != "" && !item.GracefulRan {
+		item.GracefulRan = true
+		gctx, cancel := context.WithTimeout(ctx, r.GraceTimeout)
+		view, err := HandleExecute(gctx, a, ExecuteReq{
+			Target:     vm.ID,
+			Command:    []string{"sh", "-c", r.Graceful},
+			TimeoutSec: uint64(r.GraceTimeout.Seconds()),
+			Capture:    true,
+			MaxOutput:  4096,
 		})
+		cancel()
+		if err == nil && view.ExitCode != 0 {
+			err = fmt.Errorf("exit code %d: %s", view.ExitCode, strings.TrimSpace(string(view.Stderr)))
+		}
+		if err != nil {
+			fmt.Fprintf(a.IO.Err, "Warning: shutdown command on VM '%s' failed, deleting anyway: %v\n", vm.DisplayName, err)
+		}
+	}
+
+	if !r.Commit || item.CommitID != "" {
+		return nil
+	}
+	resp, err := a.Client.Vm.Commit(ctx, vm.ID, vers.VmCommitParams{})
+	if err != nil {
+		return fmt.Errorf("safety commit failed, VM not deleted: %w", err)
+	}
+	item.CommitID = resp.CommitID
+
+	if r.Tag != "" {
+		name := killTagName(r.Tag, vm.ID)
+		if err := moveTag(ctx, a, name, resp.CommitID); err != nil {
+			fmt.Fprintf(a.IO.Err, "Warning: could not tag commit %s as '%s': %v\n", resp.CommitID, name, err)
+		} else {
+			item.Tag = name
+		}
 	}
+	return nil
+}
+
+// killTagName expands {alias} and {id} in a --tag value for one VM.
+func killTagName(tag, vmID string) string {
+	alias := utils.GetAliasByVMID(vmID)
+	if alias == "" {
+		alias = vmID
+	}
+	return strings.NewReplacer("{alias}", alias, "{id}", vmID).Replace(tag)
+}
 
-	return firstErr
+// moveTag points a tag at commitID, creating it if it doesn't exist.
+func moveTag(ctx context.Context, a *app.App, name, commitID string) error {
+	_, err := HandleTagCreate(ctx, a, TagCreateReq{TagName: name, CommitID: commitID})
+	if errorsx.ExitCodeFromError(err) == errorsx.ExitConflict {
+		return HandleTagUpdate(ctx, a, TagUpdateReq{TagName: name, CommitID: commitID})
+	}
+	return err
+}
+
+// dryRunKill resolves every target and prints the calls HandleKill would
+// make, without prompting.
+func dryRunKill(ctx context.Context, a *app.App, r KillReq, targets []string) error {
+	steps := make([]presenters.DryRunStep, 0, len(targets))
+	for _, target := range targets {
+		vmInfo, err := utils.ResolveVMIdentifier(ctx, a.Client, target)
+		if err != nil {
+			return fmt.Errorf("failed to resolve '%s': %w", target, err)
+		}
+		if r.Graceful != "" {
+			steps = append(steps, presenters.DryRunStep{
+				Call:    "exec",
+				Args:    []string{vmInfo.ID},
+				Payload: map[string]interface{}{"command": []string{"sh", "-c", r.Graceful}, "timeout": r.GraceTimeout.String()},
+			})
+		}
+		if r.Commit {
+			steps = append(steps, presenters.DryRunStep{Call: "Vm.Commit", Args: []string{vmInfo.ID}})
+		}
+		if r.Tag != "" {
+			steps = append(steps, presenters.DryRunStep{
+				Call:    "CommitTags.New",
+				Payload: map[string]string{"tag_name": killTagName(r.Tag, vmInfo.ID), "commit_id": "<safety commit>"},
+			})
+		}
+		step := presenters.DryRunStep{Call: "Vm.Delete", Args: []string{vmInfo.ID}}
+		if utils.CheckVMImpactsHead(vmInfo.ID) {
+			step.Local = []string{"clear HEAD"}
+		}
+		steps = append(steps, step)
+	}
+	dryRun(a, steps...)
+	return nil
 }
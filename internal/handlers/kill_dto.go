@@ -17,6 +17,7 @@ type KillDTO struct {
 }
 
 // HandleKillDTO performs non-interactive deletion with a structured result.
+// Protected VMs are always refused, since nobody can type their ID.
 func HandleKillDTO(ctx context.Context, a *app.App, r KillReq) (KillDTO, error) {
 	dto := KillDTO{Targets: r.Targets, Scope: "vms"}
 	targets := r.Targets
@@ -29,6 +30,14 @@ func HandleKillDTO(ctx context.Context, a *app.App, r KillReq) (KillDTO, error)
 		dto.AffectedHead = true
 	}
 
+	protected, err := utils.ProtectedTargets(targets)
+	if err != nil {
+		return dto, err
+	}
+	if len(protected) > 0 {
+		return dto, protectedError(protected, "delete them with 'vers kill --force'")
+	}
+
 	for _, ref := range targets {
 		vmInfo, err := utils.ResolveVMIdentifier(ctx, a.Client, ref)
 		if err != nil {
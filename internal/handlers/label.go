@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+type LabelReq struct {
+	Target string
+	Args   []string // "key=value" to set, "key-" to remove; none to show
+}
+
+func HandleLabel(ctx context.Context, a *app.App, r LabelReq) (presenters.LabelView, error) {
+	resolved, err := utils.ResolveTargetVM(ctx, a.Client, r.Target)
+	if err != nil {
+		return presenters.LabelView{}, err
+	}
+	v := presenters.LabelView{VMID: resolved.ID}
+
+	if len(r.Args) == 0 {
+		v.Labels = utils.GetVMLabels(resolved.ID)
+		return v, nil
+	}
+
+	set, remove, err := utils.ParseLabelArgs(r.Args)
+	if err != nil {
+		return v, err
+	}
+	v.Labels, err = utils.UpdateVMLabels(resolved.ID, set, remove)
+	v.Updated = true
+	return v, err
+}
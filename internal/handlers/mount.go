@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vmSvc "github.com/hdresearch/vers-cli/internal/services/vm"
+	sshutil "github.com/hdresearch/vers-cli/internal/ssh"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// MountReq holds the parameters for a mount command.
+type MountReq struct {
+	Target     string // VM ID, alias, or empty for HEAD
+	RemotePath string // directory on the VM to expose
+	Addr       string // local listen address for the WebDAV server
+}
+
+// HandleMount serves a VM directory as WebDAV on a local address.
+// It blocks until ctx is cancelled (e.g. Ctrl-C) or the SSH connection drops.
+func HandleMount(ctx context.Context, a *app.App, r MountReq) (presenters.MountView, error) {
+	view := presenters.MountView{}
+
+	resolved, err := utils.ResolveTarget(r.Target)
+	if err != nil {
+		return view, err
+	}
+	view.UsedHEAD = resolved.UsedHEAD
+	view.HeadID = resolved.HeadID
+
+	if r.RemotePath == "" {
+		r.RemotePath = "."
+	}
+
+	info, err := vmSvc.GetConnectInfo(ctx, a.Client, resolved.Ident)
+	if err != nil {
+		return view, fmt.Errorf("failed to get VM information: %w", err)
+	}
+
+	client := sshutil.NewClient(info.Host, info.KeyPath, info.VMDomain)
+	mount, err := client.ServeWebDAV(ctx, r.Addr, r.RemotePath)
+	if err != nil {
+		return view, fmt.Errorf("failed to start WebDAV server: %w", err)
+	}
+
+	view.VMName = info.VM.VmID
+	view.RemotePath = mount.Root
+	view.Addr = mount.Addr
+
+	// Render before blocking
+	presenters.RenderMount(a, view)
+
+	select {
+	case <-ctx.Done():
+		mount.Close()
+		return view, nil
+	case <-mount.Done():
+		if ctx.Err() != nil {
+			return view, nil
+		}
+		return view, fmt.Errorf("connection to VM %s lost", view.VMName)
+	}
+}
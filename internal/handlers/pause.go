@@ -12,19 +12,63 @@ import (
 
 type PauseReq struct{ Target string }
 
+type PauseSelectedReq struct {
+	Selector string
+	Batch    BatchOpts
+}
+
+// HandlePauseSelected pauses every VM matching a label selector with
+// runBatch. VMs that are already paused are reported as such and left
+// alone. The first error, if any, is returned after every VM was tried.
+func HandlePauseSelected(ctx context.Context, a *app.App, r PauseSelectedReq) error {
+	sctx, cancel := context.WithTimeout(ctx, a.Timeouts.APIMedium)
+	vms, err := SelectVMs(sctx, a, r.Selector)
+	cancel()
+	if err != nil {
+		return err
+	}
+	if len(vms) == 0 {
+		return fmt.Errorf("no VMs match label selector '%s'", r.Selector)
+	}
+	ids := make([]string, len(vms))
+	paused := map[string]bool{}
+	for i, vm := range vms {
+		ids[i] = vm.VmID
+		paused[vm.VmID] = vm.State == vers.VmStatePaused
+	}
+
+	r.Batch.Action = presenters.BatchPause
+	r.Batch.ItemTimeout = a.Timeouts.APIMedium
+	_, err = runBatch(ctx, a, ids, r.Batch, "VMs", "VM", func(ctx context.Context, id string, item *presenters.SummaryItem) (string, error) {
+		if paused[id] {
+			item.Note = "already paused"
+			return id, nil
+		}
+		return id, pauseVM(ctx, a, id)
+	})
+	return err
+}
+
 func HandlePause(ctx context.Context, a *app.App, r PauseReq) (presenters.PauseView, error) {
 	resolved, err := utils.ResolveTargetVM(ctx, a.Client, r.Target)
 	if err != nil {
 		return presenters.PauseView{}, err
 	}
 
-	err = a.Client.Vm.UpdateState(ctx, resolved.ID, vers.VmUpdateStateParams{
+	if err := pauseVM(ctx, a, resolved.ID); err != nil {
+		return presenters.PauseView{}, err
+	}
+	return presenters.PauseView{VMName: resolved.ID, NewState: "Paused"}, nil
+}
+
+func pauseVM(ctx context.Context, a *app.App, vmID string) error {
+	err := a.Client.Vm.UpdateState(ctx, vmID, vers.VmUpdateStateParams{
 		VmUpdateStateRequest: vers.VmUpdateStateRequestParam{
 			State: vers.F(vers.VmUpdateStateRequestStatePaused),
 		},
 	})
 	if err != nil {
-		return presenters.PauseView{}, fmt.Errorf("failed to pause VM '%s': %w", resolved.ID, err)
+		return fmt.Errorf("failed to pause VM '%s': %w", vmID, err)
 	}
-	return presenters.PauseView{VMName: resolved.ID, NewState: "Paused"}, nil
+	return nil
 }
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+type ProtectReq struct {
+	Target  string
+	Protect bool // false to unprotect
+}
+
+// HandleProtect locks or unlocks a VM against deletion. Protection is
+// local state, like aliases and labels.
+func HandleProtect(ctx context.Context, a *app.App, r ProtectReq) (presenters.ProtectView, error) {
+	resolved, err := utils.ResolveTargetVM(ctx, a.Client, r.Target)
+	if err != nil {
+		return presenters.ProtectView{}, err
+	}
+	v := presenters.ProtectView{VMID: resolved.ID, Protected: r.Protect}
+	v.Changed, err = utils.SetProtected(resolved.ID, r.Protect)
+	return v, err
+}
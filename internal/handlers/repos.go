@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
 	vers "github.com/hdresearch/vers-sdk-go"
 )
 
@@ -27,6 +28,9 @@ func HandleRepoCreate(ctx context.Context, a *app.App, r RepoCreateReq) (*vers.C
 	if r.Description != "" {
 		params.CreateRepositoryRequest.Description = vers.F(r.Description)
 	}
+	if dryRun(a, presenters.DryRunStep{Call: "Repositories.New", Payload: params.CreateRepositoryRequest}) {
+		return nil, nil
+	}
 	resp, err := a.Client.Repositories.New(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create repository '%s': %w", r.Name, err)
@@ -74,6 +78,26 @@ func HandleRepoDelete(ctx context.Context, a *app.App, r RepoDeleteReq) error {
 	return nil
 }
 
+type RepoDeleteManyReq struct {
+	Names []string
+	Batch BatchOpts
+}
+
+// HandleRepoDeleteMany deletes repositories concurrently with the batch
+// engine, reporting each result and a summary.
+func HandleRepoDeleteMany(ctx context.Context, a *app.App, r RepoDeleteManyReq) error {
+	if len(r.Names) == 0 {
+		return fmt.Errorf("repository name is required")
+	}
+	if dryRun(a, deleteSteps("Repositories.Delete", r.Names)...) {
+		return nil
+	}
+	_, err := runBatch(ctx, a, r.Names, r.Batch, "repositories", "repository", func(ctx context.Context, name string, _ *presenters.SummaryItem) (string, error) {
+		return name, a.Client.Repositories.Delete(ctx, name)
+	})
+	return err
+}
+
 type RepoSetVisibilityReq struct {
 	Name     string
 	IsPublic bool
@@ -83,11 +107,15 @@ func HandleRepoSetVisibility(ctx context.Context, a *app.App, r RepoSetVisibilit
 	if r.Name == "" {
 		return fmt.Errorf("repository name is required")
 	}
-	err := a.Client.Repositories.SetVisibility(ctx, r.Name, vers.RepositorySetVisibilityParams{
+	params := vers.RepositorySetVisibilityParams{
 		SetRepositoryVisibilityRequest: vers.SetRepositoryVisibilityRequestParam{
 			IsPublic: vers.F(r.IsPublic),
 		},
-	})
+	}
+	if dryRun(a, presenters.DryRunStep{Call: "Repositories.SetVisibility", Args: []string{r.Name}, Payload: params.SetRepositoryVisibilityRequest}) {
+		return nil
+	}
+	err := a.Client.Repositories.SetVisibility(ctx, r.Name, params)
 	if err != nil {
 		return fmt.Errorf("failed to set visibility for '%s': %w", r.Name, err)
 	}
@@ -122,6 +150,9 @@ func HandleRepoTagCreate(ctx context.Context, a *app.App, r RepoTagCreateReq) (*
 	if r.Description != "" {
 		params.CreateRepoTagRequest.Description = vers.F(r.Description)
 	}
+	if dryRun(a, presenters.DryRunStep{Call: "Repositories.NewTag", Args: []string{r.RepoName}, Payload: params.CreateRepoTagRequest}) {
+		return nil, nil
+	}
 	resp, err := a.Client.Repositories.NewTag(ctx, r.RepoName, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tag '%s' in '%s': %w", r.TagName, r.RepoName, err)
@@ -186,6 +217,9 @@ func HandleRepoTagUpdate(ctx context.Context, a *app.App, r RepoTagUpdateReq) er
 	if r.Description != "" {
 		params.UpdateRepoTagRequest.Description = vers.F(r.Description)
 	}
+	if dryRun(a, presenters.DryRunStep{Call: "Repositories.UpdateTag", Args: []string{r.RepoName, r.TagName}, Payload: params.UpdateRepoTagRequest}) {
+		return nil
+	}
 	err := a.Client.Repositories.UpdateTag(ctx, r.RepoName, r.TagName, params)
 	if err != nil {
 		return fmt.Errorf("failed to update tag '%s' in '%s': %w", r.TagName, r.RepoName, err)
@@ -205,6 +239,9 @@ func HandleRepoTagDelete(ctx context.Context, a *app.App, r RepoTagDeleteReq) er
 	if r.TagName == "" {
 		return fmt.Errorf("tag name is required")
 	}
+	if dryRun(a, presenters.DryRunStep{Call: "Repositories.DeleteTag", Args: []string{r.RepoName, r.TagName}}) {
+		return nil
+	}
 	err := a.Client.Repositories.DeleteTag(ctx, r.RepoName, r.TagName)
 	if err != nil {
 		return fmt.Errorf("failed to delete tag '%s' in '%s': %w", r.TagName, r.RepoName, err)
@@ -245,6 +282,9 @@ func HandleRepoFork(ctx context.Context, a *app.App, r RepoForkReq) (*vers.ForkR
 	if r.TagName != "" {
 		params.ForkRepositoryRequest.TagName = vers.F(r.TagName)
 	}
+	if dryRun(a, presenters.DryRunStep{Call: "Repositories.Fork", Payload: params.ForkRepositoryRequest}) {
+		return nil, nil
+	}
 	resp, err := a.Client.Repositories.Fork(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fork %s/%s:%s: %w", r.SourceOrg, r.SourceRepo, r.SourceTag, err)
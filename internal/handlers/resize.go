@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
 	"github.com/hdresearch/vers-cli/internal/utils"
 	vers "github.com/hdresearch/vers-sdk-go"
 )
@@ -24,11 +25,16 @@ func HandleResize(ctx context.Context, a *app.App, r ResizeReq) (string, error)
 		return "", err
 	}
 
-	err = a.Client.Vm.ResizeDisk(ctx, resolved.ID, vers.VmResizeDiskParams{
+	params := vers.VmResizeDiskParams{
 		VmResizeDiskRequest: vers.VmResizeDiskRequestParam{
 			FsSizeMib: vers.F(r.FsSizeMib),
 		},
-	})
+	}
+	if dryRun(a, presenters.DryRunStep{Call: "Vm.ResizeDisk", Args: []string{resolved.ID}, Payload: params.VmResizeDiskRequest}) {
+		return resolved.ID, nil
+	}
+
+	err = a.Client.Vm.ResizeDisk(ctx, resolved.ID, params)
 	if err != nil {
 		return "", fmt.Errorf("failed to resize disk for VM '%s': %w", resolved.ID, err)
 	}
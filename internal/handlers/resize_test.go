@@ -1,13 +1,16 @@
 package handlers_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/hdresearch/vers-cli/internal/app"
 	"github.com/hdresearch/vers-cli/internal/handlers"
 )
 
@@ -91,3 +94,36 @@ func TestHandleResize_APIError(t *testing.T) {
 		t.Fatal("expected error for bad request")
 	}
 }
+
+func TestHandleResize_DryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/vm/vm-123/status" && r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"vm_id": "vm-123", "owner_id": "owner-1", "created_at": "2026-03-17T00:00:00Z", "state": "Running"}`))
+			return
+		}
+		t.Errorf("unexpected request in dry-run: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	a := testApp(server.URL)
+	a.IO = app.Output{Out: &out}
+	a.DryRun = true
+
+	vmID, err := handlers.HandleResize(context.Background(), a, handlers.ResizeReq{
+		Target:    "vm-123",
+		FsSizeMib: 20480,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vmID != "vm-123" {
+		t.Errorf("expected resolved VM ID vm-123, got %s", vmID)
+	}
+	if !strings.Contains(out.String(), `Vm.ResizeDisk("vm-123")`) || !strings.Contains(out.String(), `"fs_size_mib": 20480`) {
+		t.Errorf("unexpected dry-run output:\n%s", out.String())
+	}
+}
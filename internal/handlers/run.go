@@ -43,6 +43,15 @@ func HandleRun(ctx context.Context, a *app.App, r RunReq) (presenters.RunView, e
 		},
 	}
 
+	step := presenters.DryRunStep{Call: "Vm.NewRoot", Payload: body.NewRootRequest}
+	if r.VMAlias != "" {
+		step.Local = append(step.Local, fmt.Sprintf("save alias '%s' for the new VM", r.VMAlias))
+	}
+	step.Local = append(step.Local, "set HEAD to the new VM")
+	if dryRun(a, step) {
+		return presenters.RunView{}, nil
+	}
+
 	resp, err := a.Client.Vm.NewRoot(ctx, body)
 	if err != nil {
 		return presenters.RunView{}, err
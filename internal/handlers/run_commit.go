@@ -23,6 +23,14 @@ func HandleRunCommit(ctx context.Context, a *app.App, r RunCommitReq) (presenter
 		},
 	}
 
+	step := presenters.DryRunStep{Call: "Vm.RestoreFromCommit", Payload: body.VmFromCommitRequest}
+	if r.VMAlias != "" {
+		step.Local = []string{fmt.Sprintf("save alias '%s' for the new VM", r.VMAlias)}
+	}
+	if dryRun(a, step) {
+		return presenters.RunCommitView{}, nil
+	}
+
 	resp, err := a.Client.Vm.RestoreFromCommit(ctx, body)
 	if err != nil {
 		return presenters.RunCommitView{}, err
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	svc "github.com/hdresearch/vers-cli/internal/services/status"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+// SelectVMs returns the existing VMs whose local labels match selector
+// (see utils.ParseLabelSelector). It backs the -l flag of every command that
+// operates on several VMs.
+func SelectVMs(ctx context.Context, a *app.App, selector string) ([]vers.Vm, error) {
+	sel, err := utils.ParseLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := utils.LoadLabels()
+	if err != nil {
+		return nil, err
+	}
+	vms, err := svc.ListVMs(ctx, a.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []vers.Vm
+	for _, vm := range vms {
+		if sel.Matches(labels[vm.VmID]) {
+			matched = append(matched, vm)
+		}
+	}
+	return matched, nil
+}
+
+// selectVMIDs is SelectVMs for callers that only need IDs. It fails if
+// nothing matches, since acting on zero VMs is almost always a mistake.
+func selectVMIDs(ctx context.Context, a *app.App, selector string) ([]string, error) {
+	vms, err := SelectVMs(ctx, a, selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(vms) == 0 {
+		return nil, fmt.Errorf("no VMs match label selector '%s'", selector)
+	}
+	ids := make([]string, len(vms))
+	for i, vm := range vms {
+		ids[i] = vm.VmID
+	}
+	return ids, nil
+}
@@ -9,16 +9,19 @@ import (
 	"github.com/hdresearch/vers-cli/internal/presenters"
 	svc "github.com/hdresearch/vers-cli/internal/services/status"
 	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
 )
 
 // StatusReq captures the parsed flags/args from the status command.
 type StatusReq struct {
-	Target string // optional VM identifier; if set, show VM
+	Target   string // optional VM identifier; if set, show VM
+	Selector string // optional label selector; if set, list only matching VMs
 }
 
 // HandleStatus performs the status command logic using services and utilities.
 func HandleStatus(ctx context.Context, a *app.App, req StatusReq) (presenters.StatusView, error) {
 	res := presenters.StatusView{}
+	res.Protected, _ = utils.LoadProtected()
 
 	// Show head status only when target not requested
 	res.Head.Show = (req.Target == "")
@@ -48,7 +51,13 @@ func HandleStatus(ctx context.Context, a *app.App, req StatusReq) (presenters.St
 		return res, nil
 	}
 
-	list, err := svc.ListVMs(ctx, a.Client)
+	var list []vers.Vm
+	var err error
+	if req.Selector != "" {
+		list, err = SelectVMs(ctx, a, req.Selector)
+	} else {
+		list, err = svc.ListVMs(ctx, a.Client)
+	}
 	if err != nil {
 		return res, err
 	}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	svc "github.com/hdresearch/vers-cli/internal/services/status"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+// statusHighlightMin is the shortest time a state change stays highlighted.
+const statusHighlightMin = 10 * time.Second
+
+type StatusWatchReq struct {
+	Selector string
+	Interval time.Duration
+	// JSON emits one JSON line per change instead of redrawing a table.
+	JSON bool
+}
+
+// HandleStatusWatch polls the VM list until ctx is cancelled, redrawing the
+// table on every poll or, in JSON mode, printing each change as it is seen.
+func HandleStatusWatch(ctx context.Context, a *app.App, r StatusWatchReq) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = utils.DefaultWaitInterval
+	}
+	highlight := 3 * interval
+	if highlight < statusHighlightMin {
+		highlight = statusHighlightMin
+	}
+	w := &statusWatcher{highlight: highlight}
+
+	if !r.JSON {
+		presenters.EnterWatchScreen(a)
+		defer presenters.LeaveWatchScreen(a)
+	}
+
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, a.Timeouts.APIMedium)
+		var vms []vers.Vm
+		var err error
+		if r.Selector != "" {
+			vms, err = SelectVMs(pollCtx, a, r.Selector)
+		} else {
+			vms, err = svc.ListVMs(pollCtx, a.Client)
+		}
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		now := a.Clock.Now()
+		var changes []presenters.StatusChange
+		if err != nil {
+			changes = []presenters.StatusChange{{Time: now, Type: presenters.StatusChangeError, Message: err.Error()}}
+		} else {
+			changes = w.update(vms, now)
+		}
+
+		if r.JSON {
+			for _, c := range changes {
+				presenters.PrintJSONLine(c)
+			}
+		} else {
+			v := w.view(now)
+			v.Interval = interval
+			v.HeadID, _ = utils.GetCurrentHeadVM()
+			if err != nil {
+				v.Error = err.Error()
+			}
+			presenters.RenderStatusWatch(a, v)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// statusWatcher remembers the last VM list seen by `status --watch` and
+// when each VM last changed state.
+type statusWatcher struct {
+	highlight time.Duration
+	seen      bool
+	order     []string
+	vms       map[string]*watchedVM
+}
+
+type watchedVM struct {
+	vm        vers.Vm
+	since     time.Time // when the VM entered its current state
+	prevState string
+	changedAt time.Time
+}
+
+// update records a new VM list and returns what changed since the last one.
+// Every VM in the first list is reported as added.
+func (w *statusWatcher) update(vms []vers.Vm, now time.Time) []presenters.StatusChange {
+	if w.vms == nil {
+		w.vms = map[string]*watchedVM{}
+	}
+	var changes []presenters.StatusChange
+	current := make(map[string]bool, len(vms))
+	order := make([]string, 0, len(vms))
+
+	for _, vm := range vms {
+		current[vm.VmID] = true
+		order = append(order, vm.VmID)
+		state := string(vm.State)
+
+		old, ok := w.vms[vm.VmID]
+		switch {
+		case !ok:
+			wv := &watchedVM{vm: vm, since: now}
+			if !w.seen {
+				// We don't know when it started; creation is the best guess
+				wv.since = vm.CreatedAt
+			} else {
+				wv.changedAt = now
+			}
+			w.vms[vm.VmID] = wv
+			changes = append(changes, presenters.StatusChange{Time: now, Type: presenters.StatusChangeAdded, VmID: vm.VmID, State: state})
+		case old.vm.State != vm.State:
+			prev := string(old.vm.State)
+			old.prevState, old.since, old.changedAt = prev, now, now
+			changes = append(changes, presenters.StatusChange{Time: now, Type: presenters.StatusChangeState, VmID: vm.VmID, State: state, PreviousState: prev})
+		}
+		w.vms[vm.VmID].vm = vm
+	}
+
+	for _, id := range w.order {
+		if !current[id] {
+			changes = append(changes, presenters.StatusChange{Time: now, Type: presenters.StatusChangeRemoved, VmID: id, PreviousState: string(w.vms[id].vm.State)})
+			delete(w.vms, id)
+		}
+	}
+
+	w.order = order
+	w.seen = true
+	return changes
+}
+
+func (w *statusWatcher) view(now time.Time) presenters.StatusWatchView {
+	v := presenters.StatusWatchView{Now: now}
+	aliases := map[string]string{}
+	if all, err := utils.LoadAliases(); err == nil {
+		for alias, id := range all {
+			aliases[id] = alias
+		}
+	}
+	for _, id := range w.order {
+		wv := w.vms[id]
+		row := presenters.StatusWatchRow{
+			VmID:      id,
+			Alias:     aliases[id],
+			State:     string(wv.vm.State),
+			CreatedAt: wv.vm.CreatedAt,
+			Since:     wv.since,
+			Changed:   !wv.changedAt.IsZero() && now.Sub(wv.changedAt) < w.highlight,
+		}
+		if row.Changed {
+			row.PreviousState = wv.prevState
+		}
+		v.Rows = append(v.Rows, row)
+	}
+	return v
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+func TestStatusWatcherUpdate(t *testing.T) {
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	created := start.Add(-time.Hour)
+	w := &statusWatcher{highlight: 10 * time.Second}
+
+	changes := w.update([]vers.Vm{
+		{VmID: "a", State: vers.VmStateBooting, CreatedAt: created},
+		{VmID: "b", State: vers.VmStateRunning, CreatedAt: created},
+	}, start)
+	if len(changes) != 2 || changes[0].Type != presenters.StatusChangeAdded {
+		t.Fatalf("expected two added changes, got %+v", changes)
+	}
+	if v := w.view(start); v.Rows[1].Since != created || v.Rows[1].Changed {
+		t.Errorf("initial VMs should date from creation and not be highlighted: %+v", v.Rows[1])
+	}
+
+	later := start.Add(2 * time.Second)
+	changes = w.update([]vers.Vm{
+		{VmID: "a", State: vers.VmStateRunning, CreatedAt: created},
+		{VmID: "c", State: vers.VmStateBooting, CreatedAt: later},
+	}, later)
+	want := []presenters.StatusChange{
+		{Time: later, Type: presenters.StatusChangeState, VmID: "a", State: "running", PreviousState: "booting"},
+		{Time: later, Type: presenters.StatusChangeAdded, VmID: "c", State: "booting"},
+		{Time: later, Type: presenters.StatusChangeRemoved, VmID: "b", PreviousState: "running"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %+v", len(want), changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+
+	v := w.view(later)
+	if !v.Rows[0].Changed || v.Rows[0].PreviousState != "booting" || v.Rows[0].Since != later {
+		t.Errorf("expected highlighted transition for a, got %+v", v.Rows[0])
+	}
+	if v := w.view(later.Add(time.Minute)); v.Rows[0].Changed {
+		t.Errorf("highlight should expire, got %+v", v.Rows[0])
+	}
+
+	if changes := w.update([]vers.Vm{
+		{VmID: "a", State: vers.VmStateRunning, CreatedAt: created},
+		{VmID: "c", State: vers.VmStateBooting, CreatedAt: later},
+	}, later.Add(time.Second)); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+}
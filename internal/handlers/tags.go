@@ -34,6 +34,9 @@ func HandleTagCreate(ctx context.Context, a *app.App, r TagCreateReq) (*vers.Cre
 		params.CreateTagRequest.Description = vers.F(r.Description)
 	}
 
+	if dryRun(a, presenters.DryRunStep{Call: "CommitTags.New", Payload: params.CreateTagRequest}) {
+		return nil, nil
+	}
 	resp, err := a.Client.CommitTags.New(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tag '%s': %w", r.TagName, err)
@@ -95,6 +98,9 @@ func HandleTagUpdate(ctx context.Context, a *app.App, r TagUpdateReq) error {
 		params.UpdateTagRequest.Description = vers.F(r.Description)
 	}
 
+	if dryRun(a, presenters.DryRunStep{Call: "CommitTags.Update", Args: []string{r.TagName}, Payload: params.UpdateTagRequest}) {
+		return nil
+	}
 	err := a.Client.CommitTags.Update(ctx, r.TagName, params)
 	if err != nil {
 		return fmt.Errorf("failed to update tag '%s': %w", r.TagName, err)
@@ -117,3 +123,24 @@ func HandleTagDelete(ctx context.Context, a *app.App, r TagDeleteReq) error {
 	}
 	return nil
 }
+
+// TagDeleteManyReq is the request for deleting several tags.
+type TagDeleteManyReq struct {
+	TagNames []string
+	Batch    BatchOpts
+}
+
+// HandleTagDeleteMany deletes tags concurrently with the batch engine,
+// reporting each result and a summary.
+func HandleTagDeleteMany(ctx context.Context, a *app.App, r TagDeleteManyReq) error {
+	if len(r.TagNames) == 0 {
+		return fmt.Errorf("tag name is required")
+	}
+	if dryRun(a, deleteSteps("CommitTags.Delete", r.TagNames)...) {
+		return nil
+	}
+	_, err := runBatch(ctx, a, r.TagNames, r.Batch, "tags", "tag", func(ctx context.Context, name string, _ *presenters.SummaryItem) (string, error) {
+		return name, a.Client.CommitTags.Delete(ctx, name)
+	})
+	return err
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vmSvc "github.com/hdresearch/vers-cli/internal/services/vm"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+type TreeReq struct {
+	Root string // optional VM ID, alias or commit ID to show the subtree of
+}
+
+// HandleTree assembles the lineage of all VMs into a tree: each VM hangs off
+// the commit it was started from, which hangs off the VM it was taken from.
+// Commits no VM was started from don't appear.
+func HandleTree(ctx context.Context, a *app.App, r TreeReq) (presenters.TreeView, error) {
+	lineage, err := vmSvc.ListLineage(ctx, a.Client)
+	if err != nil {
+		return presenters.TreeView{}, err
+	}
+	sort.SliceStable(lineage, func(i, k int) bool {
+		return lineage[i].VM.CreatedAt.Before(lineage[k].VM.CreatedAt)
+	})
+
+	// Best effort: names and aliases only decorate the tree
+	commitNames := map[string]string{}
+	if resp, err := a.Client.Commits.List(ctx); err == nil && resp != nil {
+		for _, c := range resp.Commits {
+			commitNames[c.CommitID] = c.Name
+		}
+	}
+	aliases := map[string]string{}
+	if all, err := utils.LoadAliases(); err == nil {
+		for alias, id := range all {
+			aliases[id] = alias
+		}
+	}
+	headID, _ := utils.GetCurrentHeadVM()
+
+	vms := map[string]*presenters.TreeNode{}
+	commits := map[string]*presenters.TreeNode{}
+	hasParent := map[*presenters.TreeNode]bool{}
+	var order []*presenters.TreeNode
+
+	vmNode := func(id string) *presenters.TreeNode {
+		if n, ok := vms[id]; ok {
+			return n
+		}
+		n := &presenters.TreeNode{Kind: presenters.TreeNodeVM, ID: id, Name: aliases[id], Head: id == headID, Deleted: true}
+		vms[id] = n
+		order = append(order, n)
+		return n
+	}
+	commitNode := func(id string) *presenters.TreeNode {
+		if n, ok := commits[id]; ok {
+			return n
+		}
+		n := &presenters.TreeNode{Kind: presenters.TreeNodeCommit, ID: id, Name: commitNames[id]}
+		commits[id] = n
+		order = append(order, n)
+		return n
+	}
+	link := func(parent, child *presenters.TreeNode) {
+		if hasParent[child] {
+			return
+		}
+		parent.Children = append(parent.Children, child)
+		hasParent[child] = true
+	}
+
+	for _, l := range lineage {
+		n := vmNode(l.VM.VmID)
+		n.State = string(l.VM.State)
+		n.Deleted = false
+	}
+	for _, l := range lineage {
+		if l.Meta.ParentCommitID == "" {
+			continue
+		}
+		c := commitNode(l.Meta.ParentCommitID)
+		link(c, vms[l.VM.VmID])
+		if l.Meta.GrandparentVmID != "" {
+			link(vmNode(l.Meta.GrandparentVmID), c)
+		}
+	}
+
+	if r.Root != "" {
+		id := utils.ResolveAlias(r.Root)
+		if n, ok := vms[id]; ok {
+			return presenters.TreeView{Roots: []*presenters.TreeNode{n}}, nil
+		}
+		if n, ok := commits[id]; ok {
+			return presenters.TreeView{Roots: []*presenters.TreeNode{n}}, nil
+		}
+		return presenters.TreeView{}, fmt.Errorf("'%s' is not a known VM or a commit any VM was started from", r.Root)
+	}
+
+	v := presenters.TreeView{}
+	for _, n := range order {
+		if !hasParent[n] {
+			v.Roots = append(v.Roots, n)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+	vmSvc "github.com/hdresearch/vers-cli/internal/services/vm"
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+type WaitReq struct {
+	Target string
+	// For holds condition specs such as "state=running", "state=deleted", "port=8080",
+	// "http=http://localhost:8080/health" or "cmd=test -f /tmp/ready".
+	// They are waited for in order. Defaults to state=running.
+	For      []string
+	Interval time.Duration
+}
+
+func HandleWait(ctx context.Context, a *app.App, r WaitReq) (presenters.WaitView, error) {
+	v := presenters.WaitView{}
+
+	specs := r.For
+	if len(specs) == 0 {
+		specs = []string{"state=running"}
+	}
+
+	resolved, err := utils.ResolveTargetVM(ctx, a.Client, r.Target)
+	if err != nil {
+		// A VM that is already gone satisfies state=deleted, so resolve it
+		// locally instead of failing
+		t, terr := utils.ResolveTarget(r.Target)
+		if terr != nil || !slices.Contains(specs, "state=deleted") || !utils.IsNotFound(err) {
+			return v, err
+		}
+		resolved = utils.ResolvedVM{ID: utils.ResolveAlias(t.Ident), UsedHEAD: t.UsedHEAD}
+	}
+	v.VMID = resolved.ID
+	v.UsedHEAD = resolved.UsedHEAD
+	conds := make([]utils.WaitCondition, len(specs))
+	for i, spec := range specs {
+		if conds[i], err = parseWaitCondition(a, resolved.ID, spec); err != nil {
+			return v, err
+		}
+	}
+
+	interval := r.Interval
+	if interval <= 0 {
+		interval = utils.DefaultWaitInterval
+	}
+
+	start := a.Clock.Now()
+	for i, cond := range conds {
+		if a.Verbose {
+			fmt.Fprintf(a.IO.Err, "Waiting for %s...\n", cond)
+		}
+		if err := utils.WaitFor(ctx, cond, interval); err != nil {
+			return v, err
+		}
+		v.Conditions = append(v.Conditions, presenters.WaitConditionView{
+			Spec:    specs[i],
+			Elapsed: a.Clock.Now().Sub(start),
+		})
+	}
+	return v, nil
+}
+
+// parseWaitCondition turns a --for spec into a condition on vmID.
+func parseWaitCondition(a *app.App, vmID, spec string) (utils.WaitCondition, error) {
+	kind, val, ok := strings.Cut(spec, "=")
+	if !ok || val == "" {
+		return nil, fmt.Errorf("invalid wait condition %q: expected kind=value (state, port, http or cmd)", spec)
+	}
+
+	switch kind {
+	case "state":
+		state := vers.VmState(val)
+		switch state {
+		case vers.VmStateRunning, vers.VmStatePaused, vers.VmStateBooting, utils.VmStateDeleted:
+		default:
+			return nil, fmt.Errorf("invalid state %q: must be running, paused, booting or deleted", val)
+		}
+		return utils.StateCondition{Client: a.Client, VMID: vmID, Want: state}, nil
+	case "port":
+		port := val
+		if _, p, ok := strings.Cut(val, ":"); ok {
+			port = p
+		}
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return nil, fmt.Errorf("invalid port %q", val)
+		}
+		return vmSvc.PortCondition(vmID, val), nil
+	case "http":
+		if !strings.HasPrefix(val, "http://") && !strings.HasPrefix(val, "https://") {
+			return nil, fmt.Errorf("invalid URL %q: must start with http:// or https://", val)
+		}
+		return vmSvc.HTTPCondition(vmID, val), nil
+	case "cmd":
+		return vmSvc.CommandCondition(vmID, val), nil
+	default:
+		return nil, fmt.Errorf("unknown wait condition %q: must be state, port, http or cmd", kind)
+	}
+}
@@ -0,0 +1,59 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/handlers"
+)
+
+func TestHandleWait_DeletedVM(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": "vm not found"}`))
+	}))
+	defer server.Close()
+
+	a := testApp(server.URL)
+	a.Clock = app.RealClock{}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	v, err := handlers.HandleWait(ctx, a, handlers.WaitReq{Target: "vm-gone", For: []string{"state=deleted"}, Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("a missing VM should satisfy state=deleted: %v", err)
+	}
+	if v.VMID != "vm-gone" || len(v.Conditions) != 1 {
+		t.Errorf("unexpected view: %+v", v)
+	}
+
+	if _, err := handlers.HandleWait(ctx, a, handlers.WaitReq{Target: "vm-gone", For: []string{"state=running"}}); err == nil {
+		t.Error("expected a missing VM to fail state=running")
+	}
+}
+
+func TestHandleWait_DeletedVMServerError(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"error": "internal error"}`))
+	}))
+	defer server.Close()
+
+	a := testApp(server.URL)
+	a.Clock = app.RealClock{}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The 404 in the ID must not be mistaken for a not-found response
+	if _, err := handlers.HandleWait(ctx, a, handlers.WaitReq{Target: "vm-404", For: []string{"state=deleted"}, Interval: time.Millisecond}); err == nil {
+		t.Error("expected a server error not to satisfy state=deleted")
+	}
+}
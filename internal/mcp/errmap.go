@@ -1,7 +1,10 @@
 package mcp
 
 import (
+	"errors"
 	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/utils"
 )
 
 // mapMCPError converts internal errors to stable, MCP-facing coded errors.
@@ -9,6 +12,9 @@ func mapMCPError(err error) error {
 	if err == nil {
 		return nil
 	}
+	if errors.Is(err, utils.ErrVMProtected) {
+		return Err(E_CONFLICT, err.Error(), map[string]any{"hint": "The VM is locked with 'vers protect'; only a user can delete it."})
+	}
 	if strings.Contains(strings.ToLower(err.Error()), "not found") {
 		return Err(E_NOT_FOUND, err.Error(), nil)
 	}
@@ -2,7 +2,10 @@ package mcp
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+
+	"github.com/hdresearch/vers-cli/internal/utils"
 )
 
 func TestMapMCPError(t *testing.T) {
@@ -12,6 +15,11 @@ func TestMapMCPError(t *testing.T) {
 		t.Fatalf("expected E_NOT_FOUND for not found")
 	}
 
+	err = mapMCPError(fmt.Errorf("%w: vm-1", utils.ErrVMProtected))
+	if !errors.As(err, &e) || e.Code != E_CONFLICT {
+		t.Fatalf("expected E_CONFLICT for protected VM, got %v", err)
+	}
+
 	// Non-matching errors pass through
 	orig := errors.New("something else")
 	err = mapMCPError(orig)
@@ -14,7 +14,7 @@ import (
 func registerKillTool(server *mcp.Server, application *app.App, opts Options) error {
 	tool := &mcp.Tool{
 		Name:        "vers.kill",
-		Description: "Delete VMs; when no targets, deletes HEAD VM",
+		Description: "Delete VMs; when no targets, deletes HEAD VM. VMs locked with `vers protect` are refused",
 	}
 	handler := withMetrics("vers.kill", func(ctx context.Context, req *mcp.CallToolRequest, in KillInput) (*mcp.CallToolResult, handlers.KillDTO, error) {
 		started := time.Now()
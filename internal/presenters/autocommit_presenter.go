@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+func AutocommitStarted(a *app.App, vmID string, every time.Duration, keep int) {
+	retention := "keeping every commit"
+	if keep > 0 {
+		retention = fmt.Sprintf("keeping the last %d", keep)
+	}
+	fmt.Fprintf(a.IO.Out, "Committing VM '%s' every %s, %s (Ctrl+C to stop)\n", vmID, every, retention)
+}
+
+func AutocommitCommitted(a *app.App, t time.Time, commitID string) {
+	fmt.Fprintf(a.IO.Out, "[%s] ✓ Committed %s\n", t.Format("15:04:05"), commitID)
+}
+
+func AutocommitPruned(a *app.App, t time.Time, commitID string) {
+	fmt.Fprintf(a.IO.Out, "[%s]   Deleted old auto-commit %s\n", t.Format("15:04:05"), commitID)
+}
+
+func AutocommitWarning(a *app.App, t time.Time, err error) {
+	fmt.Fprintf(a.IO.Err, "[%s] Warning: %v\n", t.Format("15:04:05"), err)
+}
+
+func AutocommitDaemonStarted(a *app.App, vmID string, pid int, logPath string) {
+	fmt.Fprintf(a.IO.Out, "✓ Auto-committing VM '%s' in the background (pid %d)\n", vmID, pid)
+	fmt.Fprintf(a.IO.Out, "Log: %s\n", logPath)
+	fmt.Fprintf(a.IO.Out, "Stop it with 'vers autocommit %s --stop'\n", vmID)
+}
+
+func AutocommitDaemonStopped(a *app.App, vmID string, pid int) {
+	fmt.Fprintf(a.IO.Out, "✓ Stopped auto-committing VM '%s' (pid %d)\n", vmID, pid)
+}
@@ -16,4 +16,10 @@ func RenderCopy(a *app.App, v CopyView) {
 		fmt.Printf("Downloading %s from VM %s to %s\n", v.Src, v.VMName, v.Dest)
 	}
 	fmt.Println("File copy completed successfully")
+	if v.Verified > 0 {
+		fmt.Printf("Verified %d file(s) with SHA-256\n", v.Verified)
+	}
+	if v.ChecksumFile != "" {
+		fmt.Printf("Checksum manifest written to %s\n", v.ChecksumFile)
+	}
 }
@@ -7,4 +7,7 @@ type CopyView struct {
 	Action   string
 	Src      string
 	Dest     string
+	// Verified is the number of files whose checksums matched on both ends.
+	Verified     int
+	ChecksumFile string
 }
@@ -1,12 +1,32 @@
 package presenters
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+)
 
 type SummaryResults struct {
-	SuccessCount int
-	FailCount    int
-	Errors       []string
-	ItemType     string
+	SuccessCount int           `json:"succeeded"`
+	FailCount    int           `json:"failed"`
+	Errors       []string      `json:"-"`
+	ItemType     string        `json:"item_type"`
+	Items        []SummaryItem `json:"results,omitempty"`
+}
+
+// SummaryItem is the outcome of one target of a batch operation.
+type SummaryItem struct {
+	Target     string `json:"target"`
+	ID         string `json:"id,omitempty"`
+	OK         bool   `json:"ok"`
+	Attempts   int    `json:"attempts"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+	CommitID   string `json:"commit_id,omitempty"` // safety commit taken before deleting
+	Tag        string `json:"tag,omitempty"`       // tag pointing at CommitID
+	Note       string `json:"note,omitempty"`      // e.g. "already paused" when nothing was done
+	// GracefulRan records that kill's --graceful command has been run, so
+	// retries don't run it again.
+	GracefulRan bool `json:"-"`
 }
 
 func ProgressCounter(current, total int, action, target string) {
@@ -30,7 +50,60 @@ func SectionHeader(title string) {
 	fmt.Printf("=== %s ===\n", title)
 }
 
-func PrintDeletionSummary(results SummaryResults) {
+// BatchAction names what a batch operation does to each target, for the
+// lines BatchItemResult prints.
+type BatchAction struct {
+	Done string // e.g. "Deleted"
+	Verb string // e.g. "delete"
+}
+
+var (
+	BatchDelete = BatchAction{Done: "Deleted", Verb: "delete"}
+	BatchPause  = BatchAction{Done: "Paused", Verb: "pause"}
+)
+
+// BatchItemResult prints one line as a target of a batch operation
+// finishes, e.g. "[3/40] ✓ Deleted VM 'web'". noun names the kind of item.
+func BatchItemResult(done, total int, action BatchAction, noun string, item SummaryItem) {
+	counter := ""
+	if total > 1 {
+		counter = fmt.Sprintf("[%d/%d] ", done, total)
+	}
+	detail := ""
+	if item.Attempts > 1 {
+		detail = fmt.Sprintf(" (after %d attempts)", item.Attempts)
+	}
+	if item.CommitID != "" {
+		detail += " (saved as commit " + item.CommitID
+		if item.Tag != "" {
+			detail += ", tag " + item.Tag
+		}
+		detail += ")"
+	}
+	switch {
+	case item.OK && item.Note != "":
+		fmt.Printf("%s✓ %s '%s' %s%s\n", counter, noun, item.Target, item.Note, detail)
+	case item.OK:
+		fmt.Printf("%s✓ %s %s '%s'%s\n", counter, action.Done, noun, item.Target, detail)
+	default:
+		fmt.Fprintf(os.Stderr, "%s✗ Failed to %s %s '%s'%s: %s\n", counter, action.Verb, noun, item.Target, detail, item.Error)
+	}
+}
+
+// PrintDeletionSummary prints the totals of a batch operation and the
+// reason for each failure. Structured formats print the totals and the
+// per-item results instead; csv prints one row per item.
+func PrintDeletionSummary(results SummaryResults, f OutputFormat) error {
+	if f.Structured() {
+		if results.Items == nil {
+			results.Items = []SummaryItem{}
+		}
+		if f == FormatCSV || f == FormatNDJSON {
+			return PrintStructured(f, results.Items)
+		}
+		return PrintStructured(f, results)
+	}
+
 	SectionHeader("Operation Summary")
 	fmt.Printf("✓ Successfully processed: %d %s\n", results.SuccessCount, results.ItemType)
 	if results.FailCount > 0 {
@@ -43,4 +116,20 @@ func PrintDeletionSummary(results SummaryResults) {
 			}
 		}
 	}
+	return nil
+}
+
+// Summarize totals per-item results, collecting failures as
+// "target: error" lines.
+func Summarize(itemType string, items []SummaryItem) SummaryResults {
+	res := SummaryResults{ItemType: itemType, Items: items}
+	for _, it := range items {
+		if it.OK {
+			res.SuccessCount++
+			continue
+		}
+		res.FailCount++
+		res.Errors = append(res.Errors, fmt.Sprintf("%s: %s", it.Target, it.Error))
+	}
+	return res
 }
@@ -0,0 +1,46 @@
+package presenters_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+func TestPrintDeletionSummaryStructured(t *testing.T) {
+	res := presenters.Summarize("VMs", []presenters.SummaryItem{
+		{Target: "web", ID: "vm-1", OK: true, Attempts: 1, DurationMs: 120},
+		{Target: "db", Attempts: 3, DurationMs: 4000, Error: "503 Service Unavailable"},
+	})
+	if res.SuccessCount != 1 || res.FailCount != 1 || res.Errors[0] != "db: 503 Service Unavailable" {
+		t.Fatalf("unexpected summary %+v", res)
+	}
+
+	out := captureStdout(t, func() {
+		presenters.PrintDeletionSummary(res, presenters.FormatJSON)
+	})
+	var parsed struct {
+		Succeeded int                      `json:"succeeded"`
+		Failed    int                      `json:"failed"`
+		ItemType  string                   `json:"item_type"`
+		Results   []presenters.SummaryItem `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("output is not valid JSON: %v\nOutput: %s", err, out)
+	}
+	if parsed.Succeeded != 1 || parsed.Failed != 1 || parsed.ItemType != "VMs" || len(parsed.Results) != 2 {
+		t.Errorf("unexpected JSON summary: %s", out)
+	}
+	if r := parsed.Results[1]; r.OK || r.Attempts != 3 || r.Error == "" {
+		t.Errorf("unexpected failed result %+v", r)
+	}
+
+	out = captureStdout(t, func() {
+		presenters.PrintDeletionSummary(res, presenters.FormatCSV)
+	})
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || lines[0] != "target,id,ok,attempts,duration_ms,error" {
+		t.Errorf("unexpected CSV summary:\n%s", out)
+	}
+}
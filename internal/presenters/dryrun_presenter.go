@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+// DryRunStep is an API call a command would have made without --dry-run.
+type DryRunStep struct {
+	Call    string      `json:"call"`              // SDK method (e.g. "Vm.Delete") or "PUT /api/v1/..." for raw requests
+	Args    []string    `json:"args,omitempty"`    // path arguments, e.g. the VM ID
+	Payload interface{} `json:"payload,omitempty"` // request body
+	Local   []string    `json:"local,omitempty"`   // local state it would change afterwards, e.g. HEAD
+}
+
+// RenderDryRun prints the calls a command would make, with their payloads
+// and the local state each would change.
+func RenderDryRun(a *app.App, steps []DryRunStep) {
+	for _, s := range steps {
+		if strings.Contains(s.Call, " ") {
+			fmt.Fprintf(a.IO.Out, "[dry-run] would send %s\n", s.Call)
+		} else {
+			args := make([]string, len(s.Args))
+			for i, arg := range s.Args {
+				args[i] = fmt.Sprintf("%q", arg)
+			}
+			fmt.Fprintf(a.IO.Out, "[dry-run] would call %s(%s)\n", s.Call, strings.Join(args, ", "))
+		}
+		if s.Payload != nil {
+			b, err := json.MarshalIndent(s.Payload, "    ", "  ")
+			if err != nil {
+				b = []byte(fmt.Sprintf("<%v>", err))
+			}
+			fmt.Fprintf(a.IO.Out, "    %s\n", b)
+		}
+		for _, l := range s.Local {
+			fmt.Fprintf(a.IO.Out, "    then: %s\n", l)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package presenters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+func TestRenderDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	a := &app.App{IO: app.Output{Out: &buf}}
+
+	presenters.RenderDryRun(a, []presenters.DryRunStep{
+		{Call: "Vm.Delete", Args: []string{"vm-1"}, Local: []string{"clear HEAD"}},
+		{Call: "PUT /api/v1/env_vars", Payload: map[string]any{"vars": map[string]string{"A": "1"}}},
+	})
+
+	want := `[dry-run] would call Vm.Delete("vm-1")
+    then: clear HEAD
+[dry-run] would send PUT /api/v1/env_vars
+    {
+      "vars": {
+        "A": "1"
+      }
+    }
+`
+	if buf.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
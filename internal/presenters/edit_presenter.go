@@ -0,0 +1,25 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+func RenderEdit(a *app.App, v EditView) {
+	if v.UsedHEAD {
+		fmt.Fprintf(a.IO.Out, "Using current HEAD VM: %s\n", v.HeadID)
+	}
+	switch {
+	case !v.Changed:
+		fmt.Fprintf(a.IO.Out, "No changes to %s\n", v.Path)
+	case v.Saved:
+		fmt.Fprintf(a.IO.Out, "✓ Saved %s\n", v.Path)
+	case v.LocalCopy != "":
+		fmt.Fprintf(a.IO.Out, "Not uploaded. Your edits are in %s\n", v.LocalCopy)
+	}
+}
+
+func RenderEditConflict(a *app.App, v EditView) {
+	fmt.Fprintf(a.IO.Err, "Warning: %s was modified on the VM while you were editing it.\n", v.Path)
+}
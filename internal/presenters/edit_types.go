@@ -0,0 +1,11 @@
+package presenters
+
+type EditView struct {
+	UsedHEAD  bool
+	HeadID    string
+	Path      string
+	Changed   bool   // the file was modified in the editor
+	Conflict  bool   // the remote file changed while the editor was open
+	Saved     bool   // the edited file was uploaded
+	LocalCopy string // temp file kept when the edit was not uploaded
+}
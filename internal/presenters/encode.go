@@ -0,0 +1,167 @@
+package presenters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Structured reports whether f is a machine-readable encoding handled by
+// PrintStructured.
+func (f OutputFormat) Structured() bool {
+	switch f {
+	case FormatJSON, FormatNDJSON, FormatYAML, FormatCSV:
+		return true
+	}
+	return false
+}
+
+// PrintStructured prints v to stdout in the given structured format. Field
+// names always follow the JSON encoding of v, whatever the format.
+func PrintStructured(f OutputFormat, v interface{}) error {
+	switch f {
+	case FormatNDJSON:
+		return PrintNDJSON(v)
+	case FormatYAML:
+		return PrintYAML(v)
+	case FormatCSV:
+		return PrintCSV(v)
+	default:
+		return PrintJSON(v)
+	}
+}
+
+// PrintNDJSON prints each element of a JSON array on its own line, or v
+// itself as a single line if it isn't an array.
+func PrintNDJSON(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var items []json.RawMessage
+	if json.Unmarshal(b, &items) != nil {
+		items = []json.RawMessage{b}
+	}
+	for _, item := range items {
+		if _, err := fmt.Fprintf(os.Stdout, "%s\n", item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintYAML prints v as YAML, keeping the field names and order of its JSON
+// encoding.
+func PrintYAML(v interface{}) error {
+	node, err := jsonNode(v)
+	if err != nil {
+		return err
+	}
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// PrintCSV prints v as CSV. An array of objects becomes one row per object
+// with a column per field; a single object becomes one row. Nested values
+// are written as JSON.
+func PrintCSV(v interface{}) error {
+	return writeCSV(os.Stdout, v)
+}
+
+func writeCSV(out io.Writer, v interface{}) error {
+	node, err := jsonNode(v)
+	if err != nil {
+		return err
+	}
+
+	rows := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		rows = node.Content
+	}
+
+	// Columns are the union of all fields, in the order first seen
+	var columns []string
+	index := map[string]int{}
+	for _, row := range rows {
+		if row.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i < len(row.Content); i += 2 {
+			key := row.Content[i].Value
+			if _, ok := index[key]; !ok {
+				index[key] = len(columns)
+				columns = append(columns, key)
+			}
+		}
+	}
+	if len(columns) == 0 {
+		columns = []string{"value"}
+	}
+
+	w := csv.NewWriter(out)
+	w.Write(columns)
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		if row.Kind == yaml.MappingNode {
+			for i := 0; i < len(row.Content); i += 2 {
+				record[index[row.Content[i].Value]] = csvCell(row.Content[i+1])
+			}
+		} else {
+			record[0] = csvCell(row)
+		}
+		w.Write(record)
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func csvCell(n *yaml.Node) string {
+	if n.Kind == yaml.ScalarNode {
+		if n.Tag == "!!null" {
+			return ""
+		}
+		return n.Value
+	}
+	var v interface{}
+	if err := n.Decode(&v); err != nil {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// jsonNode encodes v as JSON and parses it back as a YAML document, which
+// preserves field order. Styles picked up from the JSON syntax are cleared
+// so the YAML encoder uses block style and quotes only where needed.
+func jsonNode(v interface{}) (*yaml.Node, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.NewDecoder(bytes.NewReader(b)).Decode(&doc); err != nil {
+		return nil, err
+	}
+	node := &doc
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) == 1 {
+		node = doc.Content[0]
+	}
+	clearStyle(node)
+	return node, nil
+}
+
+func clearStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		clearStyle(c)
+	}
+}
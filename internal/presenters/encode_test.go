@@ -0,0 +1,57 @@
+package presenters_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+type encodeItem struct {
+	ID     string            `json:"id"`
+	Name   string            `json:"name,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+func TestPrintYAML(t *testing.T) {
+	out := captureStdout(t, func() {
+		presenters.PrintStructured(presenters.FormatYAML, []encodeItem{
+			{ID: "vm-1", Name: "web", Labels: map[string]string{"env": "prod"}},
+			{ID: "vm-2"},
+		})
+	})
+	want := "- id: vm-1\n  name: web\n  labels:\n    env: prod\n- id: vm-2\n"
+	if out != want {
+		t.Errorf("unexpected YAML:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestPrintCSV(t *testing.T) {
+	out := captureStdout(t, func() {
+		presenters.PrintStructured(presenters.FormatCSV, []encodeItem{
+			{ID: "vm-1"},
+			{ID: "vm-2", Name: "web, api", Labels: map[string]string{"env": "prod"}},
+		})
+	})
+	want := "id,name,labels\nvm-1,,\nvm-2,\"web, api\",\"{\"\"env\"\":\"\"prod\"\"}\"\n"
+	if out != want {
+		t.Errorf("unexpected CSV:\n%s\nwant:\n%s", out, want)
+	}
+
+	out = captureStdout(t, func() {
+		presenters.PrintStructured(presenters.FormatCSV, encodeItem{ID: "vm-1", Name: "web"})
+	})
+	if out != "id,name\nvm-1,web\n" {
+		t.Errorf("unexpected CSV for a single object: %q", out)
+	}
+}
+
+func TestPrintNDJSON(t *testing.T) {
+	out := captureStdout(t, func() {
+		presenters.PrintStructured(presenters.FormatNDJSON, []encodeItem{{ID: "vm-1"}, {ID: "vm-2"}})
+	})
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 || lines[0] != `{"id":"vm-1"}` || lines[1] != `{"id":"vm-2"}` {
+		t.Errorf("unexpected NDJSON output %q", out)
+	}
+}
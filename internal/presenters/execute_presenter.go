@@ -2,6 +2,8 @@ package presenters
 
 import (
 	"fmt"
+	"text/tabwriter"
+	"time"
 
 	"github.com/hdresearch/vers-cli/internal/app"
 )
@@ -10,4 +12,75 @@ func RenderExecute(a *app.App, v ExecuteView) {
 	if v.UsedHEAD {
 		fmt.Printf("Using current HEAD VM: %s\n", v.HeadID)
 	}
+	if v.LogPath != "" {
+		fmt.Printf("Log saved to %s\n", v.LogPath)
+	}
+}
+
+func RenderExecuteHistory(_ *app.App, v ExecuteHistoryView) {
+	if len(v.Logs) == 0 {
+		fmt.Println("No saved exec logs found")
+		fmt.Println("Save one with: vers exec --save-logs <command>")
+		return
+	}
+
+	fmt.Printf("%-20s  %-38s  %-6s  %-10s  %s\n", "STARTED", "VM", "EXIT", "DURATION", "COMMAND")
+	for _, l := range v.Logs {
+		exit := "-"
+		if l.ExitCode != nil {
+			exit = fmt.Sprintf("%d", *l.ExitCode)
+		} else if l.Error != "" {
+			exit = "error"
+		}
+		fmt.Printf("%-20s  %-38s  %-6s  %-10s  %s\n",
+			l.StartedAt.Local().Format("2006-01-02 15:04:05"),
+			l.VMID,
+			exit,
+			l.Duration,
+			l.Command,
+		)
+	}
+}
+
+// RenderExecuteResult prints the result of a captured exec as one JSON,
+// YAML or CSV document. A non-nil err is reported in the document's error
+// field.
+func RenderExecuteResult(a *app.App, v ExecuteView, err error, f OutputFormat) {
+	res := ExecuteResult{
+		VMID:            v.VMID,
+		ExecID:          v.ExecID,
+		ExitCode:        v.ExitCode,
+		Stdout:          string(v.Stdout),
+		Stderr:          string(v.Stderr),
+		StdoutTruncated: v.StdoutTruncated,
+		StderrTruncated: v.StderrTruncated,
+		DurationMs:      v.Duration.Milliseconds(),
+		TimedOut:        v.TimedOut,
+		LogPath:         v.LogPath,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	PrintStructured(f, res)
+}
+
+func RenderExecuteSummary(a *app.App, v ExecuteManyView) {
+	fmt.Fprintf(a.IO.Out, "\n=== Exec Summary ===\n")
+	w := tabwriter.NewWriter(a.IO.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TARGET\tEXIT\tDURATION\tERROR")
+	failed := 0
+	for _, r := range v.Results {
+		status := "✓"
+		if r.Failed() {
+			status = "✗"
+			failed++
+		}
+		errMsg := r.Error
+		if errMsg == "" {
+			errMsg = "-"
+		}
+		fmt.Fprintf(w, "%s %s\t%d\t%s\t%s\n", status, r.Target, r.ExitCode, r.Duration.Round(time.Millisecond), errMsg)
+	}
+	w.Flush()
+	fmt.Fprintf(a.IO.Out, "%d succeeded, %d failed\n", len(v.Results)-failed, failed)
 }
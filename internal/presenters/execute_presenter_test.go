@@ -0,0 +1,28 @@
+package presenters_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+func TestRenderExecuteSummary(t *testing.T) {
+	var buf bytes.Buffer
+	a := &app.App{IO: app.Output{Out: &buf}}
+
+	presenters.RenderExecuteSummary(a, presenters.ExecuteManyView{Results: []presenters.ExecuteTargetResult{
+		{Target: "web", Duration: 1200 * time.Millisecond},
+		{Target: "db", ExitCode: 3, Duration: 800 * time.Millisecond},
+	}})
+
+	out := buf.String()
+	for _, want := range []string{"=== Exec Summary ===", "✓ web", "✗ db", "3", "1 succeeded, 1 failed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -1,7 +1,90 @@
 package presenters
 
+import "time"
+
 type ExecuteView struct {
 	UsedHEAD bool
 	HeadID   string
+	VMID     string
 	ExitCode int
+	ExecID   string
+	Duration time.Duration
+	TimedOut bool
+	LogPath  string // saved log, when --save-logs was used
+
+	// Captured output, only set when the request asked for it
+	Stdout          []byte
+	Stderr          []byte
+	StdoutTruncated bool
+	StderrTruncated bool
+}
+
+// ExecuteResult is the document printed by `vers exec --format json`.
+type ExecuteResult struct {
+	VMID            string `json:"vm_id"`
+	ExecID          string `json:"exec_id,omitempty"`
+	ExitCode        int    `json:"exit_code"`
+	Stdout          string `json:"stdout"`
+	Stderr          string `json:"stderr"`
+	StdoutTruncated bool   `json:"stdout_truncated"`
+	StderrTruncated bool   `json:"stderr_truncated"`
+	DurationMs      int64  `json:"duration_ms"`
+	TimedOut        bool   `json:"timed_out"`
+	LogPath         string `json:"log_path,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// ExecuteEvent is a decoded exec stream event, printed one per line by
+// `vers exec --format ndjson`.
+type ExecuteEvent struct {
+	Type     string `json:"type"`
+	Stream   string `json:"stream,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Cursor   uint64 `json:"cursor,omitempty"`
+	ExecID   string `json:"exec_id,omitempty"`
+	ExitCode *int   `json:"exit_code,omitempty"`
+	Code     string `json:"code,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// ExecuteTargetResult is the outcome of running a command on one VM.
+type ExecuteTargetResult struct {
+	Target   string        `json:"target"`
+	ExitCode int           `json:"exit_code"`
+	Duration time.Duration `json:"duration_ns"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// Failed reports whether the command could not run or exited non-zero.
+func (r ExecuteTargetResult) Failed() bool {
+	return r.Error != "" || r.ExitCode != 0
+}
+
+type ExecuteManyView struct {
+	Results []ExecuteTargetResult
+}
+
+// Failed reports whether any target failed.
+func (v ExecuteManyView) Failed() bool {
+	for _, r := range v.Results {
+		if r.Failed() {
+			return true
+		}
+	}
+	return false
+}
+
+// ExecuteLogEntry is a log saved by `vers exec --save-logs`.
+type ExecuteLogEntry struct {
+	Path      string        `json:"path"`
+	VMID      string        `json:"vm_id"`
+	Command   string        `json:"command"`
+	StartedAt time.Time     `json:"started_at"`
+	ExitCode  *int          `json:"exit_code,omitempty"`
+	Duration  time.Duration `json:"duration_ns,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+type ExecuteHistoryView struct {
+	Logs []ExecuteLogEntry
 }
@@ -10,9 +10,14 @@ import (
 type OutputFormat int
 
 const (
-	FormatDefault OutputFormat = iota
-	FormatQuiet                // just IDs/names, one per line
-	FormatJSON                 // full JSON
+	FormatDefault  OutputFormat = iota
+	FormatQuiet                 // just IDs/names, one per line
+	FormatJSON                  // full JSON
+	FormatNDJSON                // one JSON object per line
+	FormatTemplate              // Go template applied to each item
+	FormatYAML                  // YAML, with the same fields as JSON
+	FormatCSV                   // comma-separated values with a header row
+	FormatWide                  // table with every available column
 )
 
 // ParseFormat returns the output format from flag values.
@@ -20,8 +25,20 @@ func ParseFormat(quiet bool, formatStr string) OutputFormat {
 	if quiet {
 		return FormatQuiet
 	}
-	if formatStr == "json" {
+	if IsTemplateFormat(formatStr) {
+		return FormatTemplate
+	}
+	switch formatStr {
+	case "json":
 		return FormatJSON
+	case "ndjson":
+		return FormatNDJSON
+	case "yaml", "yml":
+		return FormatYAML
+	case "csv":
+		return FormatCSV
+	case "wide":
+		return FormatWide
 	}
 	return FormatDefault
 }
@@ -39,3 +56,9 @@ func PrintJSON(v interface{}) error {
 	enc.SetIndent("", "  ")
 	return enc.Encode(v)
 }
+
+// PrintJSONLine marshals v to compact JSON and prints it as a single line to
+// stdout.
+func PrintJSONLine(v interface{}) error {
+	return json.NewEncoder(os.Stdout).Encode(v)
+}
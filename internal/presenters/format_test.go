@@ -20,6 +20,11 @@ func TestParseFormat(t *testing.T) {
 		{false, "", presenters.FormatDefault},
 		{true, "", presenters.FormatQuiet},
 		{false, "json", presenters.FormatJSON},
+		{false, "ndjson", presenters.FormatNDJSON},
+		{false, "yaml", presenters.FormatYAML},
+		{false, "yml", presenters.FormatYAML},
+		{false, "csv", presenters.FormatCSV},
+		{false, "wide", presenters.FormatWide},
 		{true, "json", presenters.FormatQuiet}, // quiet takes precedence
 	}
 
@@ -90,6 +95,21 @@ func TestPrintJSONArray(t *testing.T) {
 	}
 }
 
+func TestPrintJSONLine(t *testing.T) {
+	out := captureStdout(t, func() {
+		presenters.PrintJSONLine(map[string]string{"type": "chunk"})
+		presenters.PrintJSONLine(map[string]string{"type": "exit"})
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), out)
+	}
+	if lines[0] != `{"type":"chunk"}` {
+		t.Errorf("line 0 = %q", lines[0])
+	}
+}
+
 func captureStdout(t *testing.T, fn func()) string {
 	t.Helper()
 	old := os.Stdout
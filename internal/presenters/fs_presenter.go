@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+func RenderLs(_ *app.App, v LsView, long bool) {
+	if v.UsedHEAD {
+		fmt.Printf("Using current HEAD VM: %s\n", v.HeadID)
+	}
+	for _, e := range v.Entries {
+		name := e.Name
+		if e.IsDir {
+			name += "/"
+		}
+		if !long {
+			fmt.Println(name)
+			continue
+		}
+		fmt.Printf("%-11s  %12d  %s  %s\n",
+			e.Mode,
+			e.Size,
+			e.ModTime.Format("2006-01-02 15:04:05"),
+			name,
+		)
+	}
+}
+
+func RenderStat(_ *app.App, v StatView) {
+	if v.UsedHEAD {
+		fmt.Printf("Using current HEAD VM: %s\n", v.HeadID)
+	}
+	fmt.Printf("Path:      %s\n", v.Entry.Path)
+	fmt.Printf("Type:      %s\n", v.Entry.Type)
+	fmt.Printf("Mode:      %s\n", v.Entry.Mode)
+	fmt.Printf("Size:      %d\n", v.Entry.Size)
+	fmt.Printf("Modified:  %s\n", v.Entry.ModTime.Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"os"
+	"time"
+)
+
+// FileEntry describes a remote file for listing and stat output.
+type FileEntry struct {
+	Name    string    `json:"name"`
+	Path    string    `json:"path"`
+	Type    string    `json:"type"` // file, directory, symlink or other
+	Mode    string    `json:"mode"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	IsDir   bool      `json:"is_dir"`
+}
+
+// NewFileEntry builds a FileEntry for the remote file at p.
+func NewFileEntry(p string, info os.FileInfo) FileEntry {
+	kind := "other"
+	switch mode := info.Mode(); {
+	case mode.IsDir():
+		kind = "directory"
+	case mode&os.ModeSymlink != 0:
+		kind = "symlink"
+	case mode.IsRegular():
+		kind = "file"
+	}
+	return FileEntry{
+		Name:    info.Name(),
+		Path:    p,
+		Type:    kind,
+		Mode:    info.Mode().String(),
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+		IsDir:   info.IsDir(),
+	}
+}
+
+type LsView struct {
+	UsedHEAD bool
+	HeadID   string
+	Path     string
+	Entries  []FileEntry
+}
+
+type StatView struct {
+	UsedHEAD bool
+	HeadID   string
+	Entry    FileEntry
+}
+
+type FSOpView struct {
+	UsedHEAD bool
+	HeadID   string
+	Path     string
+}
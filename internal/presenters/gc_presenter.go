@@ -0,0 +1,96 @@
+package presenters
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+// GCItem is a VM considered by `vers gc`.
+type GCItem struct {
+	VmID      string    `json:"vm_id"`
+	Alias     string    `json:"alias,omitempty"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+	Age       string    `json:"age"`
+	Reason    string    `json:"reason,omitempty"` // why a VM was skipped
+	OK        bool      `json:"ok,omitempty"`
+	CommitID  string    `json:"commit_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// GCReport is the plan of `vers gc` and, once executed, its outcome.
+type GCReport struct {
+	Action    string   `json:"action"`
+	OlderThan string   `json:"older_than"`
+	States    []string `json:"states"`
+	Planned   []GCItem `json:"planned"`
+	Skipped   []GCItem `json:"skipped"`
+	Executed  bool     `json:"executed"`
+	Succeeded int      `json:"succeeded"`
+	Failed    int      `json:"failed"`
+}
+
+// RenderGCPlan prints the VMs `vers gc` would act on and those it skips.
+func RenderGCPlan(a *app.App, r GCReport) {
+	if len(r.Planned) == 0 {
+		fmt.Fprintf(a.IO.Out, "No %s VMs older than %s to %s.\n", strings.Join(r.States, "/"), r.OlderThan, r.Action)
+	} else {
+		fmt.Fprintf(a.IO.Out, "Plan: %s %d VM(s) older than %s\n", r.Action, len(r.Planned), r.OlderThan)
+		fmt.Fprintf(a.IO.Out, "  %-38s  %-16s  %-10s  %s\n", "VM ID", "ALIAS", "STATE", "AGE")
+		for _, it := range r.Planned {
+			fmt.Fprintf(a.IO.Out, "  %-38s  %-16s  %-10s  %s\n", it.VmID, orDash(it.Alias), it.State, it.Age)
+		}
+	}
+	if len(r.Skipped) > 0 {
+		fmt.Fprintf(a.IO.Out, "Skipping %d VM(s):\n", len(r.Skipped))
+		for _, it := range r.Skipped {
+			fmt.Fprintf(a.IO.Out, "  %-38s  %s\n", it.VmID, it.Reason)
+		}
+	}
+}
+
+// GCItemResult prints the outcome of one VM as `vers gc` works through the plan.
+func GCItemResult(a *app.App, done, total int, action string, it GCItem) {
+	detail := ""
+	if it.CommitID != "" {
+		detail = " (saved as commit " + it.CommitID + ")"
+	}
+	if it.OK {
+		fmt.Fprintf(a.IO.Out, "[%d/%d] ✓ %s VM '%s'%s\n", done, total, gcDone[action], it.VmID, detail)
+	} else {
+		fmt.Fprintf(a.IO.Err, "[%d/%d] ✗ Failed to %s VM '%s'%s: %s\n", done, total, action, it.VmID, detail, it.Error)
+	}
+}
+
+var gcDone = map[string]string{
+	"pause":           "Paused",
+	"kill":            "Deleted",
+	"commit-and-kill": "Committed and deleted",
+}
+
+// PrintGCReport prints the report in a structured format; csv and ndjson
+// print one row per planned VM.
+func PrintGCReport(r GCReport, f OutputFormat) error {
+	if f == FormatCSV || f == FormatNDJSON {
+		return PrintStructured(f, r.Planned)
+	}
+	return PrintStructured(f, r)
+}
+
+// RenderGCSummary prints the totals of an executed `vers gc`.
+func RenderGCSummary(a *app.App, r GCReport) {
+	if !r.Executed {
+		return
+	}
+	fmt.Fprintf(a.IO.Out, "\ngc: %d succeeded, %d failed\n", r.Succeeded, r.Failed)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
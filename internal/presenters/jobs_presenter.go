@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+func RenderJobStart(_ *app.App, v JobStartView) {
+	if v.UsedHEAD {
+		fmt.Printf("Using current HEAD VM: %s\n", v.HeadID)
+	}
+	fmt.Printf("✓ Started job %s (pid %d)\n", v.Job.ID, v.Job.PID)
+	fmt.Printf("  Logs: vers jobs logs -f %s\n", v.Job.ID)
+}
+
+func RenderJobList(_ *app.App, v JobListView) {
+	if len(v.Jobs) == 0 {
+		fmt.Println("No jobs found")
+		fmt.Println("Start one with: vers exec --detach -- <command>")
+		return
+	}
+
+	fmt.Printf("%-10s  %-38s  %-9s  %-20s  %s\n", "JOB", "VM", "STATE", "STARTED", "COMMAND")
+	for _, j := range v.Jobs {
+		state := j.State
+		if j.ExitCode != nil {
+			state = fmt.Sprintf("%s(%d)", state, *j.ExitCode)
+		}
+		fmt.Printf("%-10s  %-38s  %-9s  %-20s  %s\n",
+			j.ID,
+			j.VMID,
+			state,
+			j.StartedAt.Format("2006-01-02 15:04:05"),
+			j.Command,
+		)
+	}
+}
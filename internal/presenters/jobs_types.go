@@ -0,0 +1,39 @@
+package presenters
+
+import "time"
+
+// Job states reported by `vers jobs list`.
+const (
+	JobRunning = "running"
+	JobExited  = "exited"
+	JobStopped = "stopped" // process is gone without recording an exit code
+	JobUnknown = "unknown" // the VM could not be queried
+)
+
+// JobStatus is a detached job and its state on the VM.
+type JobStatus struct {
+	ID        string    `json:"id"`
+	VMID      string    `json:"vm_id"`
+	Command   string    `json:"command"`
+	StartedAt time.Time `json:"started_at"`
+	PID       int       `json:"pid"`
+	LogPath   string    `json:"log_path"`
+	State     string    `json:"state"`
+	ExitCode  *int      `json:"exit_code,omitempty"`
+}
+
+type JobStartView struct {
+	UsedHEAD bool
+	HeadID   string
+	Job      JobStatus
+}
+
+type JobListView struct {
+	Jobs []JobStatus
+}
+
+type JobWaitView struct {
+	ID       string
+	State    string
+	ExitCode int
+}
@@ -0,0 +1,32 @@
+package presenters
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+type LabelView struct {
+	VMID    string            `json:"vm_id"`
+	Labels  map[string]string `json:"labels"`
+	Updated bool              `json:"-"`
+}
+
+func RenderLabel(a *app.App, v LabelView) {
+	if v.Updated {
+		fmt.Fprintf(a.IO.Out, "✓ Labels updated for VM '%s'\n", v.VMID)
+	}
+	if len(v.Labels) == 0 {
+		fmt.Fprintf(a.IO.Out, "VM '%s' has no labels\n", v.VMID)
+		return
+	}
+	keys := make([]string, 0, len(v.Labels))
+	for k := range v.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(a.IO.Out, "%s=%s\n", k, v.Labels[k])
+	}
+}
@@ -0,0 +1,383 @@
+package presenters
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+// ListColumn is a field of a list command's items that can be filtered on,
+// sorted by and shown with --columns. Value returns a string, bool, int64,
+// time.Time or time.Duration; the type decides how it is compared and shown.
+type ListColumn[T any] struct {
+	Key    string
+	Header string
+	Value  func(T) any
+}
+
+// ListSpec describes the columns of a list command. Default lists the
+// columns shown when --columns isn't given.
+type ListSpec[T any] struct {
+	Columns []ListColumn[T]
+	Default []string
+}
+
+// ListOptions holds the --filter, --sort and --columns flags shared by list
+// commands.
+type ListOptions struct {
+	Filters []string // "key=value", "key!=value", "key>value", ...
+	Sort    string   // column key; "-key" sorts descending
+	Columns string   // comma-separated column keys
+}
+
+// Customized reports whether the table layout was changed, in which case
+// the generic table is rendered instead of the command's own.
+func (o ListOptions) Customized() bool {
+	return o.Sort != "" || o.Columns != ""
+}
+
+func (s ListSpec[T]) column(key string) (ListColumn[T], error) {
+	for _, c := range s.Columns {
+		if strings.EqualFold(c.Key, key) {
+			return c, nil
+		}
+	}
+	keys := make([]string, len(s.Columns))
+	for i, c := range s.Columns {
+		keys[i] = c.Key
+	}
+	return ListColumn[T]{}, fmt.Errorf("unknown field %q (available: %s)", key, strings.Join(keys, ", "))
+}
+
+// filterOps are checked longest first so ">=" isn't read as ">".
+var filterOps = []string{"!=", ">=", "<=", "=", ">", "<"}
+
+// ApplyListOptions returns the items that pass every filter, sorted as
+// requested. Items are left in their original order when no sort is given.
+func ApplyListOptions[T any](s ListSpec[T], items []T, o ListOptions) ([]T, error) {
+	type filter struct {
+		col ListColumn[T]
+		op  string
+		val string
+	}
+	var filters []filter
+	for _, f := range o.Filters {
+		var op string
+		var idx int
+		for _, candidate := range filterOps {
+			if i := strings.Index(f, candidate); i > 0 && (op == "" || i < idx) {
+				op, idx = candidate, i
+			}
+		}
+		if op == "" {
+			return nil, fmt.Errorf("invalid filter %q: expected key=value", f)
+		}
+		col, err := s.column(f[:idx])
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter %q: %w", f, err)
+		}
+		filters = append(filters, filter{col: col, op: op, val: f[idx+len(op):]})
+	}
+
+	out := make([]T, 0, len(items))
+	for _, item := range items {
+		keep := true
+		for _, f := range filters {
+			ok, err := matchListValue(f.col.Value(item), f.op, f.val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter on %s: %w", f.col.Key, err)
+			}
+			if !ok {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			out = append(out, item)
+		}
+	}
+
+	if o.Sort != "" {
+		key, desc := strings.CutPrefix(o.Sort, "-")
+		col, err := s.column(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sort: %w", err)
+		}
+		sort.SliceStable(out, func(i, k int) bool {
+			c := compareListValues(col.Value(out[i]), col.Value(out[k]))
+			if desc {
+				return c > 0
+			}
+			return c < 0
+		})
+	}
+	return out, nil
+}
+
+// matchListValue compares a column value against a filter value parsed to
+// the same type. "=" and "!=" on strings accept shell-style globs.
+func matchListValue(v any, op, raw string) (bool, error) {
+	var c int
+	switch v := v.(type) {
+	case string:
+		if op == "=" || op == "!=" {
+			matched, err := path.Match(raw, v)
+			if err != nil {
+				return false, err
+			}
+			return matched == (op == "="), nil
+		}
+		c = strings.Compare(v, raw)
+	case bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return false, fmt.Errorf("expected true or false, got %q", raw)
+		}
+		c = compareListValues(v, b)
+	case int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("expected a number, got %q", raw)
+		}
+		c = compareListValues(v, n)
+	case time.Duration:
+		d, err := parseListDuration(raw)
+		if err != nil {
+			return false, err
+		}
+		c = compareListValues(v, d)
+	case time.Time:
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			if t, err = time.Parse("2006-01-02", raw); err != nil {
+				return false, fmt.Errorf("expected a date (2006-01-02 or RFC 3339), got %q", raw)
+			}
+		}
+		c = compareListValues(v, t)
+	default:
+		c = strings.Compare(formatListValue(v), raw)
+	}
+
+	switch op {
+	case "=":
+		return c == 0, nil
+	case "!=":
+		return c != 0, nil
+	case ">":
+		return c > 0, nil
+	case "<":
+		return c < 0, nil
+	case ">=":
+		return c >= 0, nil
+	default:
+		return c <= 0, nil
+	}
+}
+
+// parseListDuration accepts Go durations plus a "d" suffix for days.
+func parseListDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseFloat(days, 64)
+		if err == nil {
+			return time.Duration(n * float64(24*time.Hour)), nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("expected a duration such as 90m, 24h or 7d, got %q", s)
+	}
+	return d, nil
+}
+
+func compareListValues(a, b any) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case bool:
+		bb := b.(bool)
+		switch {
+		case a == bb:
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	case int64:
+		bn := b.(int64)
+		switch {
+		case a < bn:
+			return -1
+		case a > bn:
+			return 1
+		}
+		return 0
+	case time.Duration:
+		return compareListValues(int64(a), int64(b.(time.Duration)))
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	}
+	return strings.Compare(formatListValue(a), formatListValue(b))
+}
+
+func formatListValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return "-"
+		}
+		return v
+	case bool:
+		if v {
+			return "yes"
+		}
+		return "no"
+	case time.Time:
+		if v.IsZero() {
+			return "-"
+		}
+		return v.Format("2006-01-02 15:04:05")
+	case time.Duration:
+		return FormatAge(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// RenderList renders items for the list formats that need the spec: wide
+// (every column), csv, and the default table when --sort or --columns
+// changed it. It reports whether it handled the format.
+func RenderList[T any](a *app.App, s ListSpec[T], items []T, o ListOptions, f OutputFormat) (bool, error) {
+	all := make([]string, len(s.Columns))
+	for i, c := range s.Columns {
+		all[i] = c.Key
+	}
+
+	switch {
+	case f == FormatWide:
+		if o.Columns != "" {
+			return true, RenderListTable(a, s, items, o.Columns)
+		}
+		return true, RenderListTable(a, s, items, strings.Join(all, ","))
+	case f == FormatCSV:
+		cols, err := s.columns(o.Columns, all)
+		if err != nil {
+			return true, err
+		}
+		w := csv.NewWriter(a.IO.Out)
+		record := make([]string, len(cols))
+		for i, c := range cols {
+			record[i] = c.Key
+		}
+		w.Write(record)
+		for _, item := range items {
+			for i, c := range cols {
+				record[i] = csvListValue(c.Value(item))
+			}
+			w.Write(record)
+		}
+		w.Flush()
+		return true, w.Error()
+	case f == FormatDefault && o.Customized():
+		return true, RenderListTable(a, s, items, o.Columns)
+	}
+	return false, nil
+}
+
+// columns resolves comma-separated column keys, or def if there are none.
+func (s ListSpec[T]) columns(keys string, def []string) ([]ListColumn[T], error) {
+	list := def
+	if keys != "" {
+		list = strings.Split(keys, ",")
+	}
+	cols := make([]ListColumn[T], len(list))
+	for i, k := range list {
+		c, err := s.column(strings.TrimSpace(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid column: %w", err)
+		}
+		cols[i] = c
+	}
+	return cols, nil
+}
+
+// RenderListTable prints items as a table of the given comma-separated
+// columns, or the spec's default columns if none are given.
+func RenderListTable[T any](a *app.App, s ListSpec[T], items []T, columns string) error {
+	cols, err := s.columns(columns, s.Default)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(a.IO.Out, 0, 0, 2, ' ', 0)
+	headers := make([]string, len(cols))
+	for i, c := range cols {
+		headers[i] = c.Header
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, item := range items {
+		vals := make([]string, len(cols))
+		for i, c := range cols {
+			vals[i] = formatListValue(c.Value(item))
+		}
+		fmt.Fprintln(w, strings.Join(vals, "\t"))
+	}
+	return w.Flush()
+}
+
+// csvListValue formats a column value for CSV, favouring machine-readable
+// forms over the table's.
+func csvListValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format(time.RFC3339)
+	case time.Duration:
+		return v.Round(time.Second).String()
+	}
+	return fmt.Sprint(v)
+}
+
+// IsTemplateFormat reports whether a --format value is a Go template.
+func IsTemplateFormat(format string) bool {
+	return strings.Contains(format, "{{")
+}
+
+// RenderListTemplate executes a text/template once per item, each followed
+// by a newline, like `docker ps --format`.
+func RenderListTemplate[T any](a *app.App, items []T, format string) error {
+	tmpl, err := template.New("format").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"join":  strings.Join,
+	}).Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %w", err)
+	}
+	for _, item := range items {
+		if err := tmpl.Execute(a.IO.Out, item); err != nil {
+			return fmt.Errorf("failed to execute --format template: %w", err)
+		}
+		io.WriteString(a.IO.Out, "\n")
+	}
+	return nil
+}
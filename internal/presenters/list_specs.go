@@ -0,0 +1,97 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+// VMListSpec describes the columns of `vers status`. aliases, labels
+// (formatted as "k=v,k=v") and protected are keyed by VM ID; now is used for
+// the age column.
+func VMListSpec(now time.Time, aliases, labels map[string]string, protected map[string]bool) ListSpec[vers.Vm] {
+	return ListSpec[vers.Vm]{
+		Columns: []ListColumn[vers.Vm]{
+			{Key: "id", Header: "VM ID", Value: func(v vers.Vm) any { return v.VmID }},
+			{Key: "alias", Header: "ALIAS", Value: func(v vers.Vm) any { return aliases[v.VmID] }},
+			{Key: "state", Header: "STATE", Value: func(v vers.Vm) any { return string(v.State) }},
+			{Key: "created", Header: "CREATED", Value: func(v vers.Vm) any { return v.CreatedAt }},
+			{Key: "age", Header: "AGE", Value: func(v vers.Vm) any { return now.Sub(v.CreatedAt) }},
+			{Key: "owner", Header: "OWNER", Value: func(v vers.Vm) any { return v.OwnerID }},
+			{Key: "labels", Header: "LABELS", Value: func(v vers.Vm) any { return labels[v.VmID] }},
+			{Key: "protected", Header: "PROTECTED", Value: func(v vers.Vm) any { return protected[v.VmID] }},
+		},
+		Default: []string{"id", "state", "created"},
+	}
+}
+
+// CommitListSpec describes the columns of `vers commit list`.
+func CommitListSpec() ListSpec[vers.CommitInfo] {
+	return ListSpec[vers.CommitInfo]{
+		Columns: []ListColumn[vers.CommitInfo]{
+			{Key: "id", Header: "COMMIT ID", Value: func(c vers.CommitInfo) any { return c.CommitID }},
+			{Key: "name", Header: "NAME", Value: func(c vers.CommitInfo) any { return c.Name }},
+			{Key: "public", Header: "PUBLIC", Value: func(c vers.CommitInfo) any { return c.IsPublic }},
+			{Key: "created", Header: "CREATED", Value: func(c vers.CommitInfo) any { return c.CreatedAt }},
+		},
+		Default: []string{"id", "name", "public", "created"},
+	}
+}
+
+// TagListSpec describes the columns of `vers tag list`.
+func TagListSpec(now time.Time) ListSpec[vers.TagInfo] {
+	return ListSpec[vers.TagInfo]{
+		Columns: []ListColumn[vers.TagInfo]{
+			{Key: "name", Header: "TAG", Value: func(t vers.TagInfo) any { return t.TagName }},
+			{Key: "commit", Header: "COMMIT", Value: func(t vers.TagInfo) any { return t.CommitID }},
+			{Key: "created", Header: "CREATED", Value: func(t vers.TagInfo) any { return t.CreatedAt }},
+			{Key: "updated", Header: "UPDATED", Value: func(t vers.TagInfo) any { return t.UpdatedAt }},
+			{Key: "age", Header: "AGE", Value: func(t vers.TagInfo) any { return now.Sub(t.CreatedAt) }},
+			{Key: "description", Header: "DESCRIPTION", Value: func(t vers.TagInfo) any { return t.Description }},
+		},
+		Default: []string{"name", "commit", "created", "description"},
+	}
+}
+
+// RepoListSpec describes the columns of `vers repo list`.
+func RepoListSpec(now time.Time) ListSpec[vers.RepositoryInfo] {
+	return ListSpec[vers.RepositoryInfo]{
+		Columns: []ListColumn[vers.RepositoryInfo]{
+			{Key: "name", Header: "NAME", Value: func(r vers.RepositoryInfo) any { return r.Name }},
+			{Key: "id", Header: "REPO ID", Value: func(r vers.RepositoryInfo) any { return r.RepoID }},
+			{Key: "public", Header: "PUBLIC", Value: func(r vers.RepositoryInfo) any { return r.IsPublic }},
+			{Key: "created", Header: "CREATED", Value: func(r vers.RepositoryInfo) any { return r.CreatedAt }},
+			{Key: "age", Header: "AGE", Value: func(r vers.RepositoryInfo) any { return now.Sub(r.CreatedAt) }},
+			{Key: "description", Header: "DESCRIPTION", Value: func(r vers.RepositoryInfo) any { return r.Description }},
+		},
+		Default: []string{"name", "public", "created", "description"},
+	}
+}
+
+// EnvVar is one entry of `vers env list`.
+type EnvVar struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// EnvVars turns the env map into a list sorted by key.
+func EnvVars(vars map[string]string) []EnvVar {
+	out := make([]EnvVar, 0, len(vars))
+	for k, v := range vars {
+		out = append(out, EnvVar{Key: k, Value: v})
+	}
+	sort.Slice(out, func(i, k int) bool { return out[i].Key < out[k].Key })
+	return out
+}
+
+// EnvListSpec describes the columns of `vers env list`.
+func EnvListSpec() ListSpec[EnvVar] {
+	return ListSpec[EnvVar]{
+		Columns: []ListColumn[EnvVar]{
+			{Key: "key", Header: "KEY", Value: func(e EnvVar) any { return e.Key }},
+			{Key: "value", Header: "VALUE", Value: func(e EnvVar) any { return e.Value }},
+		},
+		Default: []string{"key", "value"},
+	}
+}
@@ -0,0 +1,138 @@
+package presenters_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+type listItem struct {
+	Name    string
+	Public  bool
+	Created time.Time
+}
+
+var listNow = time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+func listSpec() presenters.ListSpec[listItem] {
+	return presenters.ListSpec[listItem]{
+		Columns: []presenters.ListColumn[listItem]{
+			{Key: "name", Header: "NAME", Value: func(i listItem) any { return i.Name }},
+			{Key: "public", Header: "PUBLIC", Value: func(i listItem) any { return i.Public }},
+			{Key: "age", Header: "AGE", Value: func(i listItem) any { return listNow.Sub(i.Created) }},
+		},
+		Default: []string{"name", "public"},
+	}
+}
+
+func listItems() []listItem {
+	return []listItem{
+		{Name: "web-1", Public: true, Created: listNow.Add(-2 * time.Hour)},
+		{Name: "db", Public: false, Created: listNow.Add(-72 * time.Hour)},
+		{Name: "web-2", Public: false, Created: listNow.Add(-30 * time.Minute)},
+	}
+}
+
+func names(items []listItem) string {
+	var out []string
+	for _, i := range items {
+		out = append(out, i.Name)
+	}
+	return strings.Join(out, ",")
+}
+
+func TestApplyListOptions(t *testing.T) {
+	tests := []struct {
+		opts presenters.ListOptions
+		want string
+	}{
+		{presenters.ListOptions{}, "web-1,db,web-2"},
+		{presenters.ListOptions{Filters: []string{"name=web-*"}}, "web-1,web-2"},
+		{presenters.ListOptions{Filters: []string{"name!=web-*"}}, "db"},
+		{presenters.ListOptions{Filters: []string{"public=true"}}, "web-1"},
+		{presenters.ListOptions{Filters: []string{"age>1h"}}, "web-1,db"},
+		{presenters.ListOptions{Filters: []string{"age>=2d"}}, "db"},
+		{presenters.ListOptions{Filters: []string{"age<1h", "name=web-*"}}, "web-2"},
+		{presenters.ListOptions{Sort: "name"}, "db,web-1,web-2"},
+		{presenters.ListOptions{Sort: "-age"}, "db,web-1,web-2"},
+		{presenters.ListOptions{Sort: "AGE"}, "web-2,web-1,db"},
+	}
+	for _, tt := range tests {
+		got, err := presenters.ApplyListOptions(listSpec(), listItems(), tt.opts)
+		if err != nil {
+			t.Fatalf("%+v: %v", tt.opts, err)
+		}
+		if names(got) != tt.want {
+			t.Errorf("%+v: got %s, want %s", tt.opts, names(got), tt.want)
+		}
+	}
+
+	for _, bad := range []presenters.ListOptions{
+		{Filters: []string{"color=red"}},
+		{Filters: []string{"name"}},
+		{Filters: []string{"age>soon"}},
+		{Sort: "color"},
+	} {
+		if _, err := presenters.ApplyListOptions(listSpec(), listItems(), bad); err == nil {
+			t.Errorf("%+v: expected error", bad)
+		}
+	}
+}
+
+func TestRenderListTableAndTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	a := &app.App{IO: app.Output{Out: &buf}}
+
+	if err := presenters.RenderListTable(a, listSpec(), listItems()[:2], "name,age"); err != nil {
+		t.Fatal(err)
+	}
+	want := "NAME   AGE\nweb-1  2h0m\ndb     3d0h\n"
+	if buf.String() != want {
+		t.Errorf("unexpected table:\n%q\nwant:\n%q", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := presenters.RenderListTemplate(a, listItems()[:2], "{{.Name}} {{upper .Name}} {{.Public}}"); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "web-1 WEB-1 true\ndb DB false\n" {
+		t.Errorf("unexpected template output %q", buf.String())
+	}
+
+	if err := presenters.RenderListTemplate(a, listItems(), "{{.Name"); err == nil {
+		t.Error("expected template parse error")
+	}
+	if presenters.ParseFormat(false, "{{.Name}}") != presenters.FormatTemplate {
+		t.Error("expected template format")
+	}
+}
+
+func TestRenderListWideAndCSV(t *testing.T) {
+	var buf bytes.Buffer
+	a := &app.App{IO: app.Output{Out: &buf}}
+
+	ok, err := presenters.RenderList(a, listSpec(), listItems()[:1], presenters.ListOptions{}, presenters.FormatWide)
+	if !ok || err != nil {
+		t.Fatalf("wide: ok=%v err=%v", ok, err)
+	}
+	if want := "NAME   PUBLIC  AGE\nweb-1  yes     2h0m\n"; buf.String() != want {
+		t.Errorf("unexpected wide table:\n%q\nwant:\n%q", buf.String(), want)
+	}
+
+	buf.Reset()
+	ok, err = presenters.RenderList(a, listSpec(), listItems()[:2], presenters.ListOptions{}, presenters.FormatCSV)
+	if !ok || err != nil {
+		t.Fatalf("csv: ok=%v err=%v", ok, err)
+	}
+	if want := "name,public,age\nweb-1,true,2h0m0s\ndb,false,72h0m0s\n"; buf.String() != want {
+		t.Errorf("unexpected csv:\n%q\nwant:\n%q", buf.String(), want)
+	}
+
+	if ok, _ := presenters.RenderList(a, listSpec(), listItems(), presenters.ListOptions{}, presenters.FormatDefault); ok {
+		t.Error("default format without --sort or --columns should be left to the command")
+	}
+}
@@ -0,0 +1,15 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+func RenderMount(a *app.App, v MountView) {
+	if v.UsedHEAD {
+		fmt.Fprintf(a.IO.Out, "Using current HEAD VM: %s\n", v.HeadID)
+	}
+	fmt.Fprintf(a.IO.Out, "Serving %s on VM %s over WebDAV at http://%s/\n", v.RemotePath, v.VMName, v.Addr)
+	fmt.Fprintln(a.IO.Out, "Press Ctrl-C to unmount.")
+}
@@ -0,0 +1,10 @@
+package presenters
+
+// MountView holds data for rendering a filesystem mount.
+type MountView struct {
+	UsedHEAD   bool
+	HeadID     string
+	VMName     string
+	RemotePath string
+	Addr       string
+}
@@ -0,0 +1,75 @@
+package presenters
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+)
+
+// TimestampLayout is the format of the timestamps added by WithTimestamps.
+const TimestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// PrefixWriter prefixes every line written to it before passing it on to an
+// underlying writer. Whole lines are written in a single call while holding
+// mu, so several PrefixWriters sharing one mutex can interleave output from
+// concurrent sources without splitting lines.
+type PrefixWriter struct {
+	w      io.Writer
+	prefix []byte
+	mu     *sync.Mutex
+	buf    []byte
+	now    func() time.Time
+}
+
+// NewPrefixWriter returns a PrefixWriter that writes to w. mu may be shared
+// between writers that target the same destination.
+func NewPrefixWriter(w io.Writer, prefix string, mu *sync.Mutex) *PrefixWriter {
+	return &PrefixWriter{w: w, prefix: []byte(prefix), mu: mu}
+}
+
+// WithTimestamps makes the writer start every line with the time it was
+// written, as returned by now, ahead of the prefix.
+func (p *PrefixWriter) WithTimestamps(now func() time.Time) *PrefixWriter {
+	p.now = now
+	return p
+}
+
+func (p *PrefixWriter) Write(b []byte) (int, error) {
+	p.buf = append(p.buf, b...)
+	for {
+		i := bytes.IndexByte(p.buf, '\n')
+		if i < 0 {
+			break
+		}
+		if err := p.emit(p.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		p.buf = p.buf[i+1:]
+	}
+	return len(b), nil
+}
+
+// Flush writes any buffered partial line, terminated with a newline.
+func (p *PrefixWriter) Flush() error {
+	if len(p.buf) == 0 {
+		return nil
+	}
+	line := append(p.buf, '\n')
+	p.buf = nil
+	return p.emit(line)
+}
+
+func (p *PrefixWriter) emit(line []byte) error {
+	out := make([]byte, 0, len(TimestampLayout)+1+len(p.prefix)+len(line))
+	if p.now != nil {
+		out = p.now().AppendFormat(out, TimestampLayout)
+		out = append(out, ' ')
+	}
+	out = append(out, p.prefix...)
+	out = append(out, line...)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err := p.w.Write(out)
+	return err
+}
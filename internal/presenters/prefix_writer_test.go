@@ -0,0 +1,40 @@
+package presenters_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+func TestPrefixWriter(t *testing.T) {
+	var out bytes.Buffer
+	var mu sync.Mutex
+	w := presenters.NewPrefixWriter(&out, "[vm1] ", &mu)
+
+	w.Write([]byte("hello\nwor"))
+	w.Write([]byte("ld\npartial"))
+	if got, want := out.String(), "[vm1] hello\n[vm1] world\n"; got != want {
+		t.Fatalf("before flush got %q, want %q", got, want)
+	}
+
+	w.Flush()
+	if got, want := out.String(), "[vm1] hello\n[vm1] world\n[vm1] partial\n"; got != want {
+		t.Fatalf("after flush got %q, want %q", got, want)
+	}
+}
+
+func TestPrefixWriterTimestamps(t *testing.T) {
+	var out bytes.Buffer
+	var mu sync.Mutex
+	now := time.Date(2025, 3, 1, 12, 30, 0, 250e6, time.UTC)
+	w := presenters.NewPrefixWriter(&out, "", &mu).WithTimestamps(func() time.Time { return now })
+
+	w.Write([]byte("one\ntwo\n"))
+	want := "2025-03-01T12:30:00.250Z one\n2025-03-01T12:30:00.250Z two\n"
+	if got := out.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
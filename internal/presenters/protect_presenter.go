@@ -0,0 +1,26 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+type ProtectView struct {
+	VMID      string `json:"vm_id"`
+	Protected bool   `json:"protected"`
+	Changed   bool   `json:"changed"`
+}
+
+func RenderProtect(a *app.App, v ProtectView) {
+	switch {
+	case v.Protected && v.Changed:
+		fmt.Fprintf(a.IO.Out, "🔒 VM '%s' is now protected from deletion\n", v.VMID)
+	case v.Protected:
+		fmt.Fprintf(a.IO.Out, "VM '%s' is already protected\n", v.VMID)
+	case v.Changed:
+		fmt.Fprintf(a.IO.Out, "✓ VM '%s' is no longer protected\n", v.VMID)
+	default:
+		fmt.Fprintf(a.IO.Out, "VM '%s' is not protected\n", v.VMID)
+	}
+}
@@ -26,11 +26,14 @@ func RenderStatus(a *app.App, res StatusView) {
 	switch res.Mode {
 	case StatusVM:
 		RenderVMStatus(res.VM)
+		if res.Protected[res.VM.VmID] {
+			fmt.Println("Locked:   🔒 protected from deletion")
+		}
 	default:
 		if len(res.VMs) == 0 {
 			fmt.Println("No VMs found.")
 			return
 		}
-		RenderVMList(res.VMs)
+		RenderVMList(res.VMs, res.Protected)
 	}
 }
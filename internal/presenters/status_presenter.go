@@ -13,13 +13,19 @@ func RenderVMStatus(vm *vers.Vm) {
 	fmt.Printf("Created:  %s\n", vm.CreatedAt.Format("2006-01-02 15:04:05"))
 }
 
-func RenderVMList(vms []vers.Vm) {
+// RenderVMList prints a table of VMs, marking protected ones with a lock.
+func RenderVMList(vms []vers.Vm, protected map[string]bool) {
 	fmt.Printf("%-38s  %-10s  %s\n", "VM ID", "STATE", "CREATED")
 	for _, vm := range vms {
-		fmt.Printf("%-38s  %-10s  %s\n",
+		lock := ""
+		if protected[vm.VmID] {
+			lock = "  🔒"
+		}
+		fmt.Printf("%-38s  %-10s  %s%s\n",
 			vm.VmID,
 			vm.State,
 			vm.CreatedAt.Format("2006-01-02 15:04:05"),
+			lock,
 		)
 	}
 }
@@ -23,4 +23,6 @@ type StatusView struct {
 	Head StatusHead
 	VM   *vers.Vm
 	VMs  []vers.Vm
+	// Protected holds the IDs of VMs locked with `vers protect`.
+	Protected map[string]bool
 }
@@ -0,0 +1,120 @@
+package presenters
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+// Status change types emitted by `vers status --watch`.
+const (
+	StatusChangeAdded   = "added"
+	StatusChangeRemoved = "removed"
+	StatusChangeState   = "state"
+	StatusChangeError   = "error"
+)
+
+// StatusChange is one line of `vers status --watch` output when stdout is
+// not a terminal.
+type StatusChange struct {
+	Time          time.Time `json:"time"`
+	Type          string    `json:"type"`
+	VmID          string    `json:"vm_id,omitempty"`
+	State         string    `json:"state,omitempty"`
+	PreviousState string    `json:"previous_state,omitempty"`
+	Message       string    `json:"message,omitempty"`
+}
+
+// StatusWatchRow is a VM in the live status table.
+type StatusWatchRow struct {
+	VmID          string
+	Alias         string
+	State         string
+	PreviousState string    // set while the row is highlighted
+	CreatedAt     time.Time // for the AGE column
+	Since         time.Time // when the VM entered State; zero if unknown
+	Changed       bool      // state changed recently
+}
+
+type StatusWatchView struct {
+	Rows     []StatusWatchRow
+	HeadID   string
+	Now      time.Time
+	Interval time.Duration
+	Error    string // last poll error, if any
+}
+
+// ANSI sequences used by the watch screen.
+const (
+	ansiAltScreenOn  = "\x1b[?1049h\x1b[?25l"
+	ansiAltScreenOff = "\x1b[?25h\x1b[?1049l"
+	ansiClear        = "\x1b[H\x1b[2J"
+	ansiHighlight    = "\x1b[1;33m"
+	ansiReset        = "\x1b[0m"
+)
+
+// EnterWatchScreen switches the terminal to an alternate screen so the
+// user's scrollback is restored on exit.
+func EnterWatchScreen(a *app.App) { io.WriteString(a.IO.Out, ansiAltScreenOn) }
+
+// LeaveWatchScreen restores the normal screen.
+func LeaveWatchScreen(a *app.App) { io.WriteString(a.IO.Out, ansiAltScreenOff) }
+
+// RenderStatusWatch redraws the live status table in place.
+func RenderStatusWatch(a *app.App, v StatusWatchView) {
+	var b strings.Builder
+	b.WriteString(ansiClear)
+	fmt.Fprintf(&b, "Every %s · updated %s · Ctrl-C to quit\n\n", v.Interval, v.Now.Format("15:04:05"))
+	if v.HeadID != "" {
+		fmt.Fprintf(&b, "HEAD: %s\n\n", v.HeadID)
+	}
+	if v.Error != "" {
+		fmt.Fprintf(&b, "⚠ %s\n\n", v.Error)
+	}
+
+	if len(v.Rows) == 0 {
+		b.WriteString("No VMs found.\n")
+	} else {
+		fmt.Fprintf(&b, "%-38s  %-16s  %-20s  %-8s  %s\n", "VM ID", "ALIAS", "STATE", "AGE", "UPTIME")
+		for _, r := range v.Rows {
+			alias := r.Alias
+			if alias == "" {
+				alias = "-"
+			}
+			state := r.State
+			if r.Changed && r.PreviousState != "" {
+				state = r.PreviousState + " → " + r.State
+			}
+			uptime := "-"
+			if r.State == "running" && !r.Since.IsZero() {
+				uptime = FormatAge(v.Now.Sub(r.Since))
+			}
+			line := fmt.Sprintf("%-38s  %-16s  %-20s  %-8s  %s", r.VmID, alias, state, FormatAge(v.Now.Sub(r.CreatedAt)), uptime)
+			if r.Changed {
+				line = ansiHighlight + line + ansiReset
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	io.WriteString(a.IO.Out, b.String())
+}
+
+// FormatAge renders a duration compactly, e.g. "45s", "12m", "3h4m", "2d5h".
+func FormatAge(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
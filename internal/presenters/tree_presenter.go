@@ -0,0 +1,140 @@
+package presenters
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+// RenderTree draws the lineage as an ASCII tree.
+func RenderTree(a *app.App, v TreeView) {
+	if len(v.Roots) == 0 {
+		fmt.Fprintln(a.IO.Out, "No VMs found")
+		return
+	}
+	for _, root := range v.Roots {
+		fmt.Fprintln(a.IO.Out, treeLabel(root))
+		renderTreeChildren(a.IO.Out, root, "")
+	}
+}
+
+func renderTreeChildren(w io.Writer, n *TreeNode, indent string) {
+	for i, c := range n.Children {
+		branch, next := "├── ", "│   "
+		if i == len(n.Children)-1 {
+			branch, next = "└── ", "    "
+		}
+		fmt.Fprintf(w, "%s%s%s\n", indent, branch, treeLabel(c))
+		renderTreeChildren(w, c, indent+next)
+	}
+}
+
+func treeLabel(n *TreeNode) string {
+	var b strings.Builder
+	if n.Kind == TreeNodeCommit {
+		b.WriteString("◆ commit " + n.ID)
+		if n.Name != "" {
+			fmt.Fprintf(&b, " %q", n.Name)
+		}
+		return b.String()
+	}
+
+	b.WriteString("● " + n.ID)
+	if n.Name != "" {
+		fmt.Fprintf(&b, " (%s)", n.Name)
+	}
+	switch {
+	case n.Deleted:
+		b.WriteString(" [deleted]")
+	case n.State != "":
+		fmt.Fprintf(&b, " [%s]", n.State)
+	}
+	if n.Head {
+		b.WriteString(" ← HEAD")
+	}
+	return b.String()
+}
+
+// RenderTreeDot writes the lineage as a Graphviz digraph.
+func RenderTreeDot(a *app.App, v TreeView) {
+	w := a.IO.Out
+	fmt.Fprintln(w, "digraph vers {")
+	fmt.Fprintln(w, "  node [fontname=\"monospace\"];")
+	walkTree(v.Roots, func(n, parent *TreeNode) {
+		shape := "box"
+		if n.Kind == TreeNodeCommit {
+			shape = "ellipse"
+		}
+		attrs := fmt.Sprintf("label=%q, shape=%s", strings.Join(treeLines(n), "\n"), shape)
+		if n.Head {
+			attrs += ", penwidth=2"
+		}
+		if n.Deleted {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(w, "  %q [%s];\n", n.ID, attrs)
+		if parent != nil {
+			fmt.Fprintf(w, "  %q -> %q;\n", parent.ID, n.ID)
+		}
+	})
+	fmt.Fprintln(w, "}")
+}
+
+// RenderTreeMermaid writes the lineage as a Mermaid flowchart.
+func RenderTreeMermaid(a *app.App, v TreeView) {
+	w := a.IO.Out
+	fmt.Fprintln(w, "graph TD")
+	ids := map[*TreeNode]string{}
+	walkTree(v.Roots, func(n, parent *TreeNode) {
+		id := fmt.Sprintf("n%d", len(ids))
+		ids[n] = id
+		label := strings.ReplaceAll(strings.Join(treeLines(n), "<br/>"), `"`, "#quot;")
+		if n.Kind == TreeNodeCommit {
+			fmt.Fprintf(w, "  %s([\"%s\"])\n", id, label)
+		} else {
+			fmt.Fprintf(w, "  %s[\"%s\"]\n", id, label)
+		}
+		if parent != nil {
+			fmt.Fprintf(w, "  %s --> %s\n", ids[parent], id)
+		}
+	})
+}
+
+// treeLines describes a node for graph exports, one fact per line.
+func treeLines(n *TreeNode) []string {
+	var lines []string
+	if n.Name != "" {
+		lines = append(lines, n.Name)
+	}
+	if n.Kind == TreeNodeCommit {
+		lines = append(lines, "commit "+n.ID)
+		return lines
+	}
+	lines = append(lines, n.ID)
+	switch {
+	case n.Deleted:
+		lines = append(lines, "deleted")
+	case n.State != "":
+		lines = append(lines, n.State)
+	}
+	if n.Head {
+		lines = append(lines, "HEAD")
+	}
+	return lines
+}
+
+// walkTree visits every node depth-first, parents before children.
+func walkTree(roots []*TreeNode, visit func(n, parent *TreeNode)) {
+	var walk func(n, parent *TreeNode)
+	walk = func(n, parent *TreeNode) {
+		visit(n, parent)
+		for _, c := range n.Children {
+			walk(c, n)
+		}
+	}
+	for _, r := range roots {
+		walk(r, nil)
+	}
+}
@@ -0,0 +1,57 @@
+package presenters_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+	"github.com/hdresearch/vers-cli/internal/presenters"
+)
+
+func sampleTree() presenters.TreeView {
+	return presenters.TreeView{Roots: []*presenters.TreeNode{{
+		Kind: presenters.TreeNodeVM, ID: "vm-1", Name: "base", State: "running",
+		Children: []*presenters.TreeNode{{
+			Kind: presenters.TreeNodeCommit, ID: "c-1", Name: "setup",
+			Children: []*presenters.TreeNode{
+				{Kind: presenters.TreeNodeVM, ID: "vm-2", State: "paused"},
+				{Kind: presenters.TreeNodeVM, ID: "vm-3", State: "running", Head: true},
+			},
+		}},
+	}}}
+}
+
+func TestRenderTree(t *testing.T) {
+	var buf bytes.Buffer
+	presenters.RenderTree(&app.App{IO: app.Output{Out: &buf}}, sampleTree())
+
+	want := strings.Join([]string{
+		"● vm-1 (base) [running]",
+		`└── ◆ commit c-1 "setup"`,
+		"    ├── ● vm-2 [paused]",
+		"    └── ● vm-3 [running] ← HEAD",
+		"",
+	}, "\n")
+	if buf.String() != want {
+		t.Errorf("unexpected tree:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRenderTreeMermaid(t *testing.T) {
+	var buf bytes.Buffer
+	presenters.RenderTreeMermaid(&app.App{IO: app.Output{Out: &buf}}, sampleTree())
+
+	out := buf.String()
+	for _, want := range []string{
+		"graph TD\n",
+		`  n0["base<br/>vm-1<br/>running"]`,
+		`  n1(["setup<br/>commit c-1"])`,
+		"  n0 --> n1\n",
+		"  n1 --> n3\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("mermaid output missing %q:\n%s", want, out)
+		}
+	}
+}
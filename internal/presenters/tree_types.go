@@ -0,0 +1,23 @@
+package presenters
+
+// Tree node kinds.
+const (
+	TreeNodeVM     = "vm"
+	TreeNodeCommit = "commit"
+)
+
+// TreeNode is a VM or commit in the lineage tree. A VM's children are the
+// commits taken from it; a commit's children are the VMs started from it.
+type TreeNode struct {
+	Kind     string      `json:"kind"`
+	ID       string      `json:"id"`
+	Name     string      `json:"name,omitempty"` // alias for VMs, name for commits
+	State    string      `json:"state,omitempty"`
+	Head     bool        `json:"head,omitempty"`
+	Deleted  bool        `json:"deleted,omitempty"`
+	Children []*TreeNode `json:"children,omitempty"`
+}
+
+type TreeView struct {
+	Roots []*TreeNode
+}
@@ -0,0 +1,28 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/app"
+)
+
+type WaitConditionView struct {
+	Spec    string
+	Elapsed time.Duration
+}
+
+type WaitView struct {
+	VMID       string
+	UsedHEAD   bool
+	Conditions []WaitConditionView
+}
+
+func RenderWait(a *app.App, v WaitView) {
+	if v.UsedHEAD {
+		fmt.Fprintf(a.IO.Out, "Using current HEAD VM: %s\n", v.VMID)
+	}
+	for _, c := range v.Conditions {
+		fmt.Fprintf(a.IO.Out, "✓ %s (%s)\n", c.Spec, c.Elapsed.Round(100*time.Millisecond))
+	}
+}
@@ -0,0 +1,81 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hdresearch/vers-cli/internal/utils"
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+// lineageConcurrency bounds concurrent metadata requests in ListLineage.
+const lineageConcurrency = 8
+
+// Lineage pairs a VM with its lineage metadata: the commit it was started
+// from and the VM that commit was taken from.
+type Lineage struct {
+	VM   vers.Vm
+	Meta *vers.VmMetadataResponse
+}
+
+// ListLineage returns every VM together with its metadata. VMs deleted
+// between listing them and fetching their metadata are left out.
+func ListLineage(ctx context.Context, client *vers.Client) ([]Lineage, error) {
+	vms, err := client.Vm.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VMs: %w", err)
+	}
+
+	out := make([]Lineage, len(*vms))
+	errs := make([]error, len(*vms))
+	sem := make(chan struct{}, lineageConcurrency)
+	var wg sync.WaitGroup
+	for i, vm := range *vms {
+		wg.Add(1)
+		go func(i int, vm vers.Vm) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			meta, err := client.Vm.GetMetadata(ctx, vm.VmID)
+			if utils.IsNotFound(err) {
+				return
+			}
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to get metadata for VM '%s': %w", vm.VmID, err)
+				return
+			}
+			out[i] = Lineage{VM: vm, Meta: meta}
+		}(i, vm)
+	}
+	wg.Wait()
+
+	lineage := make([]Lineage, 0, len(out))
+	for i, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+		if out[i].Meta != nil {
+			lineage = append(lineage, out[i])
+		}
+	}
+	return lineage, nil
+}
+
+// ListChildren returns the IDs of VMs branched from parentID, i.e. VMs whose
+// lineage metadata records parentID as their grandparent VM.
+func ListChildren(ctx context.Context, client *vers.Client, parentID string) ([]string, error) {
+	lineage, err := ListLineage(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var children []string
+	for _, l := range lineage {
+		if l.VM.VmID != parentID && l.Meta.GrandparentVmID == parentID {
+			children = append(children, l.VM.VmID)
+		}
+	}
+	return children, nil
+}
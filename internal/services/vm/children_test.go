@@ -0,0 +1,41 @@
+package vm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	vers "github.com/hdresearch/vers-sdk-go"
+	"github.com/hdresearch/vers-sdk-go/option"
+)
+
+func TestListLineageSkipsDeletedVMs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/vm-gone/metadata"):
+			// Deleted after the list was taken
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "vm not found"}`))
+		case strings.HasSuffix(r.URL.Path, "/vm-1/metadata"):
+			w.Write([]byte(`{"vm_id": "vm-1", "owner_id": "owner-1", "created_at": "2026-03-17T00:00:00Z", "state": "Running", "grandparent_vm_id": "vm-0"}`))
+		default:
+			w.Write([]byte(`[
+				{"vm_id": "vm-1", "owner_id": "owner-1", "created_at": "2026-03-17T00:00:00Z", "state": "Running"},
+				{"vm_id": "vm-gone", "owner_id": "owner-1", "created_at": "2026-03-17T00:00:00Z", "state": "Running"}
+			]`))
+		}
+	}))
+	defer server.Close()
+
+	client := vers.NewClient(option.WithBaseURL(server.URL), option.WithAPIKey("test-key"))
+	lineage, err := ListLineage(context.Background(), client)
+	if err != nil {
+		t.Fatalf("a VM deleted concurrently should be skipped: %v", err)
+	}
+	if len(lineage) != 1 || lineage[0].VM.VmID != "vm-1" || lineage[0].Meta.GrandparentVmID != "vm-0" {
+		t.Errorf("unexpected lineage: %+v", lineage)
+	}
+}
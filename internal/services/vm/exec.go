@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/hdresearch/vers-cli/internal/auth"
 )
@@ -18,6 +19,10 @@ type ExecRequest struct {
 	WorkingDir string            `json:"working_dir,omitempty"`
 	Stdin      string            `json:"stdin,omitempty"`
 	TimeoutSec uint64            `json:"timeout_secs,omitempty"`
+
+	// StdinStream marks a request whose stdin follows in the body as
+	// NDJSON frames (see ExecStreamStdin).
+	StdinStream bool `json:"stdin_stream,omitempty"`
 }
 
 // ExecResponse matches the orchestrator's VmExecResponse.
@@ -83,6 +88,24 @@ func Exec(ctx context.Context, vmID string, req ExecRequest) (*ExecResponse, err
 // ExecStream runs a command on a VM via the orchestrator streaming API.
 // It returns the response body for the caller to consume as NDJSON.
 func ExecStream(ctx context.Context, vmID string, req ExecRequest) (io.ReadCloser, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	return openExecStream(ctx, "POST", fmt.Sprintf("/api/v1/vm/%s/exec/stream", vmID), bytes.NewReader(body))
+}
+
+// ExecAttach reattaches to a running or finished exec and streams its events
+// after cursor as NDJSON, in the same format as ExecStream.
+func ExecAttach(ctx context.Context, vmID, execID string, cursor uint64) (io.ReadCloser, error) {
+	path := fmt.Sprintf("/api/v1/vm/%s/exec/%s/stream?cursor=%d", vmID, url.PathEscape(execID), cursor)
+	return openExecStream(ctx, "GET", path, nil)
+}
+
+// openExecStream sends an authenticated request to the orchestrator and
+// returns the response body of a successful NDJSON stream.
+func openExecStream(ctx context.Context, method, path string, body io.Reader) (io.ReadCloser, error) {
 	apiKey, err := auth.GetAPIKey()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get API key: %w", err)
@@ -93,19 +116,15 @@ func ExecStream(ctx context.Context, vmID string, req ExecRequest) (io.ReadClose
 		return nil, fmt.Errorf("failed to get API URL: %w", err)
 	}
 
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	url := fmt.Sprintf("%s/api/v1/vm/%s/exec/stream", baseURL.String(), vmID)
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	httpReq, err := http.NewRequestWithContext(ctx, method, baseURL.String()+path, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	httpReq.Header.Set("Authorization", "Bearer "+apiKey)
-	httpReq.Header.Set("Content-Type", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := http.DefaultClient.Do(httpReq)
 	if err != nil {
@@ -0,0 +1,155 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hdresearch/vers-cli/internal/auth"
+)
+
+// ErrStdinStreamUnsupported is returned by ExecStreamStdin when the
+// orchestrator rejects a streamed-stdin request before any stdin was sent,
+// so the caller can still fall back to another transport.
+var ErrStdinStreamUnsupported = errors.New("orchestrator does not support streaming stdin")
+
+// stdinChunkSize is the amount of stdin read per upload frame.
+const stdinChunkSize = 64 * 1024
+
+// stdinFrame is one NDJSON line of a streamed-stdin request body.
+type stdinFrame struct {
+	Type    string `json:"type"` // "stdin" or "stdin_eof"
+	DataB64 string `json:"data_b64,omitempty"`
+}
+
+// ExecStreamStdin is like ExecStream but uploads stdin incrementally instead
+// of sending it as a string. The request body is NDJSON: the ExecRequest on
+// the first line, followed by "stdin" frames and a final "stdin_eof" frame.
+//
+// stdin is only read as fast as the connection accepts the body, so a slow
+// VM applies backpressure to the local producer.
+func ExecStreamStdin(ctx context.Context, vmID string, req ExecRequest, stdin io.Reader) (io.ReadCloser, error) {
+	apiKey, err := auth.GetAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	baseURL, err := auth.GetVersUrl()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API URL: %w", err)
+	}
+
+	req.Stdin = ""
+	req.StdinStream = true
+	head, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	frames := &stdinFrameReader{src: stdin, pending: append(head, '\n')}
+
+	url := fmt.Sprintf("%s/api/v1/vm/%s/exec/stream", baseURL.String(), vmID)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, frames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	httpReq.Header.Set("Content-Type", "application/x-ndjson")
+	// Hold the body until the server agrees to take it, so a server that
+	// rejects the request doesn't consume any stdin.
+	httpReq.Header.Set("Expect", "100-continue")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		errBody, _ := io.ReadAll(resp.Body)
+		if stdinStreamRejected(resp.StatusCode, string(errBody)) {
+			if !frames.readStdin.Load() {
+				return nil, fmt.Errorf("%w: API error %d: %s", ErrStdinStreamUnsupported, resp.StatusCode, string(errBody))
+			}
+			// Too late to fall back: the stdin that was sent is gone
+			return nil, fmt.Errorf("API error %d after stdin was sent; the orchestrator may not support --stream-stdin: %s", resp.StatusCode, string(errBody))
+		}
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(errBody))
+	}
+
+	return resp.Body, nil
+}
+
+// stdinStreamRejected reports whether an error response means the
+// orchestrator doesn't support streamed stdin, rather than that the request
+// itself was bad: the endpoint, method or content type is unknown, the
+// NDJSON body couldn't be processed (422), or a 400 names the stdin_stream
+// field. Any other 400 may be a malformed command and is not retried.
+func stdinStreamRejected(status int, body string) bool {
+	switch status {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity:
+		return true
+	case http.StatusBadRequest:
+		return strings.Contains(body, "stdin_stream")
+	}
+	return false
+}
+
+// stdinFrameReader encodes src as NDJSON stdin frames on demand, after
+// first returning the pending request header.
+type stdinFrameReader struct {
+	src       io.Reader
+	pending   []byte
+	buf       []byte
+	readStdin atomic.Bool // set from the transport's body-writing goroutine
+	done      bool
+}
+
+func (r *stdinFrameReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.nextFrame(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// nextFrame reads the next chunk of stdin into pending.
+func (r *stdinFrameReader) nextFrame() error {
+	if r.buf == nil {
+		r.buf = make([]byte, stdinChunkSize)
+	}
+	n, err := r.src.Read(r.buf)
+	if n > 0 {
+		r.readStdin.Store(true)
+		r.pending = encodeFrame(stdinFrame{Type: "stdin", DataB64: base64.StdEncoding.EncodeToString(r.buf[:n])})
+	}
+	if err == io.EOF {
+		r.pending = append(r.pending, encodeFrame(stdinFrame{Type: "stdin_eof"})...)
+		r.done = true
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read stdin: %w", err)
+	}
+	return nil
+}
+
+func encodeFrame(f stdinFrame) []byte {
+	var b bytes.Buffer
+	_ = json.NewEncoder(&b).Encode(f)
+	return b.Bytes()
+}
@@ -0,0 +1,54 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExecStreamStdinFallback(t *testing.T) {
+	t.Setenv("VERS_API_KEY", "test-key")
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		readBody    bool
+		unsupported bool
+	}{
+		{name: "unknown endpoint", status: http.StatusNotFound, body: "not found", unsupported: true},
+		{name: "unprocessable body", status: http.StatusUnprocessableEntity, body: "invalid request", unsupported: true},
+		{name: "stdin_stream rejected", status: http.StatusBadRequest, body: "unknown field `stdin_stream`", unsupported: true},
+		{name: "malformed request", status: http.StatusBadRequest, body: "command must not be empty"},
+		{name: "stdin already sent", status: http.StatusNotFound, body: "not found", readBody: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.readBody {
+					io.Copy(io.Discard, r.Body)
+				}
+				w.WriteHeader(tt.status)
+				io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+			t.Setenv("VERS_URL", server.URL)
+
+			stdin := strings.NewReader("hello")
+			_, err := ExecStreamStdin(context.Background(), "vm-1", ExecRequest{Command: []string{"cat"}}, stdin)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := errors.Is(err, ErrStdinStreamUnsupported); got != tt.unsupported {
+				t.Errorf("unsupported = %v, want %v (%v)", got, tt.unsupported, err)
+			}
+			if !tt.readBody && stdin.Len() != 5 {
+				t.Errorf("stdin was consumed before the server accepted the request")
+			}
+		})
+	}
+}
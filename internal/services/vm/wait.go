@@ -0,0 +1,73 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hdresearch/vers-cli/internal/errorsx"
+	"github.com/hdresearch/vers-cli/internal/utils"
+)
+
+// waitExecTimeoutSec bounds each probe run inside the VM.
+const waitExecTimeoutSec = 10
+
+// ExecCondition holds once Command exits 0 inside the VM. Transient failures
+// to run the command at all, and conflicts while the VM is still booting,
+// count as not ready yet; other errors, such as auth or not found, end the
+// wait.
+type ExecCondition struct {
+	VMID    string
+	Command []string
+	Label   string
+}
+
+func (c ExecCondition) Check(ctx context.Context) (bool, error) {
+	resp, err := Exec(ctx, c.VMID, ExecRequest{Command: c.Command, TimeoutSec: waitExecTimeoutSec})
+	if err != nil {
+		if ctx.Err() != nil || errorsx.IsTransient(err) || errorsx.ExitCodeFromError(err) == errorsx.ExitConflict {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check %s: %w", c.Label, err)
+	}
+	return resp.ExitCode == 0, nil
+}
+
+func (c ExecCondition) String() string {
+	return c.Label
+}
+
+// CommandCondition waits for a shell command to succeed inside the VM.
+func CommandCondition(vmID, command string) ExecCondition {
+	return ExecCondition{
+		VMID:    vmID,
+		Command: []string{"sh", "-c", command},
+		Label:   fmt.Sprintf("`%s` to succeed on VM %s", command, vmID),
+	}
+}
+
+// PortCondition waits for something to accept TCP connections on addr
+// ("port" or "host:port") as seen from inside the VM.
+func PortCondition(vmID, addr string) ExecCondition {
+	host, port := "127.0.0.1", addr
+	if h, p, ok := strings.Cut(addr, ":"); ok {
+		host, port = h, p
+	}
+	return ExecCondition{
+		VMID:    vmID,
+		Command: []string{"bash", "-c", fmt.Sprintf("exec 3<>/dev/tcp/%s/%s", host, port)},
+		Label:   fmt.Sprintf("port %s to accept connections on VM %s", addr, vmID),
+	}
+}
+
+// HTTPCondition waits for url to return a successful response when fetched
+// from inside the VM, using curl or, failing that, wget.
+func HTTPCondition(vmID, url string) ExecCondition {
+	q := utils.ShellQuote(url)
+	script := fmt.Sprintf("if command -v curl >/dev/null 2>&1; then curl -fsS -o /dev/null --max-time 5 %s; else wget -q -O /dev/null -T 5 %s; fi", q, q)
+	return ExecCondition{
+		VMID:    vmID,
+		Command: []string{"sh", "-c", script},
+		Label:   fmt.Sprintf("%s to respond on VM %s", url, vmID),
+	}
+}
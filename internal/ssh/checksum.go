@@ -0,0 +1,106 @@
+package ssh
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrChecksumMismatch is returned when a transferred file's SHA-256 digest
+// differs between the local machine and the VM.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// FileChecksum records the SHA-256 digest of a transferred file.
+type FileChecksum struct {
+	LocalPath  string `json:"local_path"`
+	RemotePath string `json:"remote_path"`
+	SHA256     string `json:"sha256"`
+	Size       int64  `json:"size"`
+	Verified   bool   `json:"verified"`
+}
+
+// fileSum is the digest and length of the bytes that went over the wire.
+type fileSum struct {
+	digest string
+	size   int64
+}
+
+// fileHasher hashes bytes as they are copied and counts them.
+type fileHasher struct {
+	h    hash.Hash
+	size int64
+}
+
+func newFileHasher() *fileHasher { return &fileHasher{h: sha256.New()} }
+
+func (f *fileHasher) Write(p []byte) (int, error) {
+	f.size += int64(len(p))
+	return f.h.Write(p)
+}
+
+func (f *fileHasher) sum() fileSum {
+	return fileSum{digest: hex.EncodeToString(f.h.Sum(nil)), size: f.size}
+}
+
+// record verifies a transferred file against the VM if requested and adds it
+// to the transfer's checksum list. It returns ErrChecksumMismatch when the
+// digests differ so the caller can retry.
+func (t *transfer) record(localPath, remotePath string, sum fileSum) error {
+	if !t.opts.Checksums && !t.opts.Verify {
+		return nil
+	}
+
+	fc := FileChecksum{
+		LocalPath:  localPath,
+		RemotePath: remotePath,
+		SHA256:     sum.digest,
+		Size:       sum.size,
+	}
+
+	if t.opts.Verify {
+		remote, err := remoteSHA256(t.conn, remotePath)
+		if err != nil {
+			return err
+		}
+		if remote != sum.digest {
+			return fmt.Errorf("%w for %s: local %s, remote %s", ErrChecksumMismatch, remotePath, sum.digest, remote)
+		}
+		fc.Verified = true
+	}
+
+	t.checksums = append(t.checksums, fc)
+	return nil
+}
+
+// remoteSHA256 runs sha256sum on the VM over a fresh session and returns the
+// hex digest of remotePath.
+func remoteSHA256(client *ssh.Client, remotePath string) (string, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return "", fmt.Errorf("new session: %w", err)
+	}
+	defer session.Close()
+
+	out, err := session.Output("sha256sum -- " + quotePath(remotePath))
+	if err != nil {
+		return "", fmt.Errorf("remote sha256sum %s: %w", remotePath, err)
+	}
+
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("remote sha256sum %s: empty output", remotePath)
+	}
+	// sha256sum prefixes the digest with a backslash when the file name
+	// contains special characters.
+	return strings.TrimPrefix(fields[0], `\`), nil
+}
+
+// quotePath single-quotes a path for a POSIX shell.
+func quotePath(p string) string {
+	return "'" + strings.ReplaceAll(p, "'", `'\''`) + "'"
+}
@@ -0,0 +1,58 @@
+package ssh
+
+import "testing"
+
+func TestFileHasher(t *testing.T) {
+	h := newFileHasher()
+	h.Write([]byte("hello "))
+	h.Write([]byte("world"))
+	sum := h.sum()
+
+	// sha256("hello world")
+	want := "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	if sum.digest != want {
+		t.Errorf("digest = %q, want %q", sum.digest, want)
+	}
+	if sum.size != 11 {
+		t.Errorf("size = %d, want 11", sum.size)
+	}
+}
+
+func TestQuotePath(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"/data/file.bin", "'/data/file.bin'"},
+		{"/data/my file", "'/data/my file'"},
+		{"/data/it's", `'/data/it'\''s'`},
+	}
+	for _, tt := range tests {
+		if got := quotePath(tt.in); got != tt.want {
+			t.Errorf("quotePath(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRecord_SkipsWhenDisabled(t *testing.T) {
+	tr := &transfer{}
+	if err := tr.record("a", "/a", fileSum{digest: "x", size: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tr.checksums) != 0 {
+		t.Errorf("expected no checksums recorded, got %d", len(tr.checksums))
+	}
+}
+
+func TestRecord_ChecksumsOnly(t *testing.T) {
+	tr := &transfer{opts: TransferOptions{Checksums: true}}
+	if err := tr.record("a", "/a", fileSum{digest: "abc", size: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tr.checksums) != 1 {
+		t.Fatalf("expected 1 checksum, got %d", len(tr.checksums))
+	}
+	got := tr.checksums[0]
+	if got.SHA256 != "abc" || got.Size != 3 || got.Verified {
+		t.Errorf("unexpected checksum: %+v", got)
+	}
+}
@@ -176,9 +176,12 @@ func (c *Client) Interactive(ctx context.Context, stdin io.Reader, stdout, stder
 		return fmt.Errorf("start shell: %w", err)
 	}
 
-	// Handle terminal resize if we have a real terminal
+	// Handle terminal resize if we have a real terminal, until the
+	// session ends
 	if isTerm {
-		go c.watchResize(ctx, fd, session)
+		resizeCtx, stopResize := context.WithCancel(ctx)
+		defer stopResize()
+		go c.watchResize(resizeCtx, fd, session)
 	}
 
 	// Wait for session to end or context cancellation
@@ -244,9 +247,12 @@ func (c *Client) InteractiveCommand(ctx context.Context, cmd string, stdin io.Re
 		return fmt.Errorf("start command: %w", err)
 	}
 
-	// Handle terminal resize if we have a real terminal
+	// Handle terminal resize if we have a real terminal, until the
+	// session ends
 	if isTerm {
-		go c.watchResize(ctx, fd, session)
+		resizeCtx, stopResize := context.WithCancel(ctx)
+		defer stopResize()
+		go c.watchResize(resizeCtx, fd, session)
 	}
 
 	// Wait for session to end or context cancellation
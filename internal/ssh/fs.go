@@ -0,0 +1,166 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/pkg/sftp"
+)
+
+// withSFTP opens an SSH connection and SFTP session, runs fn, and closes both.
+func (c *Client) withSFTP(ctx context.Context, fn func(*sftp.Client) error) error {
+	client, err := c.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	sftpClient, err := sftp.NewClient(client)
+	if err != nil {
+		return fmt.Errorf("SFTP client: %w", err)
+	}
+	defer sftpClient.Close()
+
+	return fn(sftpClient)
+}
+
+// List lists a remote directory, sorted by name. If remotePath is not a
+// directory, it returns that file's info as the only entry and isDir is false.
+func (c *Client) List(ctx context.Context, remotePath string) (entries []os.FileInfo, isDir bool, err error) {
+	err = c.withSFTP(ctx, func(s *sftp.Client) error {
+		info, err := s.Stat(remotePath)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", remotePath, err)
+		}
+		if !info.IsDir() {
+			entries = []os.FileInfo{info}
+			return nil
+		}
+		isDir = true
+		entries, err = s.ReadDirContext(ctx, remotePath)
+		if err != nil {
+			return fmt.Errorf("read directory %s: %w", remotePath, err)
+		}
+		return nil
+	})
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, isDir, err
+}
+
+// Stat returns file info for a remote path without following a final symlink.
+func (c *Client) Stat(ctx context.Context, remotePath string) (os.FileInfo, error) {
+	var info os.FileInfo
+	err := c.withSFTP(ctx, func(s *sftp.Client) error {
+		var err error
+		info, err = s.Lstat(remotePath)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", remotePath, err)
+		}
+		return nil
+	})
+	return info, err
+}
+
+// Cat streams the contents of a remote file to w.
+func (c *Client) Cat(ctx context.Context, remotePath string, w io.Writer) error {
+	return c.withSFTP(ctx, func(s *sftp.Client) error {
+		f, err := s.Open(remotePath)
+		if err != nil {
+			return fmt.Errorf("open %s: %w", remotePath, err)
+		}
+		defer f.Close()
+
+		if _, err := io.Copy(w, f); err != nil {
+			return fmt.Errorf("read %s: %w", remotePath, err)
+		}
+		return nil
+	})
+}
+
+// Remove deletes a remote file or empty directory. With recursive set it
+// also removes directories and their contents.
+func (c *Client) Remove(ctx context.Context, remotePath string, recursive bool) error {
+	return c.withSFTP(ctx, func(s *sftp.Client) error {
+		var err error
+		if recursive {
+			err = s.RemoveAll(remotePath)
+		} else {
+			err = s.Remove(remotePath)
+		}
+		if err != nil {
+			return fmt.Errorf("remove %s: %w", remotePath, err)
+		}
+		return nil
+	})
+}
+
+// Mkdir creates a remote directory. With parents set it creates any missing
+// parent directories and does not fail if the directory already exists.
+func (c *Client) Mkdir(ctx context.Context, remotePath string, parents bool) error {
+	return c.withSFTP(ctx, func(s *sftp.Client) error {
+		var err error
+		if parents {
+			err = s.MkdirAll(remotePath)
+		} else {
+			err = s.Mkdir(remotePath)
+		}
+		if err != nil {
+			return fmt.Errorf("mkdir %s: %w", remotePath, err)
+		}
+		return nil
+	})
+}
+
+// ReadFile returns the contents and permission bits of a remote file.
+func (c *Client) ReadFile(ctx context.Context, remotePath string) ([]byte, os.FileMode, error) {
+	var data []byte
+	var mode os.FileMode
+	err := c.withSFTP(ctx, func(s *sftp.Client) error {
+		f, err := s.Open(remotePath)
+		if err != nil {
+			return fmt.Errorf("open %s: %w", remotePath, err)
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", remotePath, err)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("%s is a directory", remotePath)
+		}
+		mode = info.Mode().Perm()
+
+		data, err = io.ReadAll(f)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", remotePath, err)
+		}
+		return nil
+	})
+	return data, mode, err
+}
+
+// WriteFile replaces the contents of a remote file and sets its permission
+// bits to mode.
+func (c *Client) WriteFile(ctx context.Context, remotePath string, data []byte, mode os.FileMode) error {
+	return c.withSFTP(ctx, func(s *sftp.Client) error {
+		f, err := s.OpenFile(remotePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+		if err != nil {
+			return fmt.Errorf("open %s: %w", remotePath, err)
+		}
+		if _, err := f.Write(data); err != nil {
+			f.Close()
+			return fmt.Errorf("write %s: %w", remotePath, err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("close %s: %w", remotePath, err)
+		}
+		if err := s.Chmod(remotePath, mode); err != nil {
+			return fmt.Errorf("chmod %s: %w", remotePath, err)
+		}
+		return nil
+	})
+}
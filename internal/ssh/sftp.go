@@ -2,81 +2,148 @@ package ssh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
 )
 
+// TransferOptions controls optional behaviour of file transfers.
+type TransferOptions struct {
+	// Checksums records the SHA-256 digest of every transferred file.
+	Checksums bool
+	// Verify compares the local SHA-256 digest of every transferred file
+	// against the digest computed on the VM with sha256sum. Implies Checksums.
+	Verify bool
+	// Retries is how many times a file is re-transferred after a checksum
+	// mismatch before the transfer fails.
+	Retries int
+}
+
+// transfer holds the state shared by a single Upload or Download call.
+type transfer struct {
+	conn      *ssh.Client
+	sftp      *sftp.Client
+	opts      TransferOptions
+	checksums []FileChecksum
+}
+
 // Upload copies a local file or directory to the remote host.
 func (c *Client) Upload(ctx context.Context, localPath, remotePath string, recursive bool) error {
+	_, err := c.UploadWithOptions(ctx, localPath, remotePath, recursive, TransferOptions{})
+	return err
+}
+
+// UploadWithOptions copies a local file or directory to the remote host and
+// returns the checksums of the transferred files when requested by opts.
+func (c *Client) UploadWithOptions(ctx context.Context, localPath, remotePath string, recursive bool, opts TransferOptions) ([]FileChecksum, error) {
 	client, err := c.Connect(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer client.Close()
 
 	sftpClient, err := sftp.NewClient(client)
 	if err != nil {
-		return fmt.Errorf("SFTP client: %w", err)
+		return nil, fmt.Errorf("SFTP client: %w", err)
 	}
 	defer sftpClient.Close()
 
+	t := &transfer{conn: client, sftp: sftpClient, opts: opts}
+
 	// Check if local path is a directory
 	info, err := os.Stat(localPath)
 	if err != nil {
-		return fmt.Errorf("stat local path: %w", err)
+		return nil, fmt.Errorf("stat local path: %w", err)
 	}
 
 	if info.IsDir() {
 		if !recursive {
-			return fmt.Errorf("source is a directory, use recursive mode")
+			return nil, fmt.Errorf("source is a directory, use recursive mode")
 		}
-		return uploadDir(sftpClient, localPath, remotePath)
+		err = t.uploadDir(localPath, remotePath)
+	} else {
+		remoteInfo, statErr := sftpClient.Stat(remotePath)
+		remoteIsDir := statErr == nil && remoteInfo.IsDir()
+		err = t.uploadFile(localPath, uploadFilePath(localPath, remotePath, remoteIsDir))
 	}
+	return t.checksums, err
+}
 
-	return uploadFile(sftpClient, localPath, remotePath)
+// uploadFilePath returns where a single uploaded file goes. Like cp and
+// scp, a destination ending in "/" or naming an existing directory gets
+// the file under its local name.
+func uploadFilePath(localPath, remotePath string, remoteIsDir bool) string {
+	if remoteIsDir || strings.HasSuffix(remotePath, "/") {
+		return path.Join(remotePath, filepath.Base(localPath))
+	}
+	return remotePath
 }
 
-// uploadFile copies a single file to the remote host.
-func uploadFile(sftpClient *sftp.Client, localPath, remotePath string) error {
+// uploadFile copies a single file to the remote host, verifying and retrying
+// on checksum mismatch if requested.
+func (t *transfer) uploadFile(localPath, remotePath string) error {
+	for attempt := 0; ; attempt++ {
+		sum, err := t.uploadFileOnce(localPath, remotePath)
+		if err != nil {
+			return err
+		}
+		err = t.record(localPath, remotePath, sum)
+		if !errors.Is(err, ErrChecksumMismatch) || attempt >= t.opts.Retries {
+			return err
+		}
+	}
+}
+
+// uploadFileOnce copies a single file to the remote host and returns the
+// local SHA-256 digest of the bytes sent.
+func (t *transfer) uploadFileOnce(localPath, remotePath string) (fileSum, error) {
 	localFile, err := os.Open(localPath)
 	if err != nil {
-		return fmt.Errorf("open local file: %w", err)
+		return fileSum{}, fmt.Errorf("open local file: %w", err)
 	}
 	defer localFile.Close()
 
 	// Get local file info for permissions
 	info, err := localFile.Stat()
 	if err != nil {
-		return fmt.Errorf("stat local file: %w", err)
+		return fileSum{}, fmt.Errorf("stat local file: %w", err)
 	}
 
 	// Create remote file
-	remoteFile, err := sftpClient.Create(remotePath)
+	remoteFile, err := t.sftp.Create(remotePath)
 	if err != nil {
-		return fmt.Errorf("create remote file: %w", err)
+		return fileSum{}, fmt.Errorf("create remote file: %w", err)
 	}
-	defer remoteFile.Close()
 
-	// Copy contents
-	if _, err := io.Copy(remoteFile, localFile); err != nil {
-		return fmt.Errorf("copy file: %w", err)
+	// Copy contents, hashing what we send
+	h := newFileHasher()
+	if _, err := io.Copy(remoteFile, io.TeeReader(localFile, h)); err != nil {
+		remoteFile.Close()
+		return fileSum{}, fmt.Errorf("copy file: %w", err)
+	}
+	// Close before verifying so the remote side sees the full contents
+	if err := remoteFile.Close(); err != nil {
+		return fileSum{}, fmt.Errorf("close remote file: %w", err)
 	}
 
 	// Set permissions
-	if err := sftpClient.Chmod(remotePath, info.Mode()); err != nil {
+	if err := t.sftp.Chmod(remotePath, info.Mode()); err != nil {
 		// Non-fatal: some systems may not support all permission bits
 		_ = err
 	}
 
-	return nil
+	return h.sum(), nil
 }
 
 // uploadDir recursively copies a directory to the remote host.
-func uploadDir(sftpClient *sftp.Client, localDir, remoteDir string) error {
+func (t *transfer) uploadDir(localDir, remoteDir string) error {
 	return filepath.Walk(localDir, func(localPath string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -93,71 +160,98 @@ func uploadDir(sftpClient *sftp.Client, localDir, remoteDir string) error {
 
 		if info.IsDir() {
 			// Create remote directory
-			if err := sftpClient.MkdirAll(remotePath); err != nil {
+			if err := t.sftp.MkdirAll(remotePath); err != nil {
 				return fmt.Errorf("mkdir %s: %w", remotePath, err)
 			}
 			return nil
 		}
 
 		// Upload file
-		return uploadFile(sftpClient, localPath, remotePath)
+		return t.uploadFile(localPath, remotePath)
 	})
 }
 
 // Download copies a remote file or directory to the local host.
 func (c *Client) Download(ctx context.Context, remotePath, localPath string, recursive bool) error {
+	_, err := c.DownloadWithOptions(ctx, remotePath, localPath, recursive, TransferOptions{})
+	return err
+}
+
+// DownloadWithOptions copies a remote file or directory to the local host and
+// returns the checksums of the transferred files when requested by opts.
+func (c *Client) DownloadWithOptions(ctx context.Context, remotePath, localPath string, recursive bool, opts TransferOptions) ([]FileChecksum, error) {
 	client, err := c.Connect(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer client.Close()
 
 	sftpClient, err := sftp.NewClient(client)
 	if err != nil {
-		return fmt.Errorf("SFTP client: %w", err)
+		return nil, fmt.Errorf("SFTP client: %w", err)
 	}
 	defer sftpClient.Close()
 
+	t := &transfer{conn: client, sftp: sftpClient, opts: opts}
+
 	// Check if remote path is a directory
 	info, err := sftpClient.Stat(remotePath)
 	if err != nil {
-		return fmt.Errorf("stat remote path: %w", err)
+		return nil, fmt.Errorf("stat remote path: %w", err)
 	}
 
 	if info.IsDir() {
 		if !recursive {
-			return fmt.Errorf("source is a directory, use recursive mode")
+			return nil, fmt.Errorf("source is a directory, use recursive mode")
 		}
-		return downloadDir(sftpClient, remotePath, localPath)
+		err = t.downloadDir(remotePath, localPath)
+	} else {
+		err = t.downloadFile(remotePath, localPath)
 	}
+	return t.checksums, err
+}
 
-	return downloadFile(sftpClient, remotePath, localPath)
+// downloadFile copies a single file from the remote host, verifying and
+// retrying on checksum mismatch if requested.
+func (t *transfer) downloadFile(remotePath, localPath string) error {
+	for attempt := 0; ; attempt++ {
+		sum, err := t.downloadFileOnce(remotePath, localPath)
+		if err != nil {
+			return err
+		}
+		err = t.record(localPath, remotePath, sum)
+		if !errors.Is(err, ErrChecksumMismatch) || attempt >= t.opts.Retries {
+			return err
+		}
+	}
 }
 
-// downloadFile copies a single file from the remote host.
-func downloadFile(sftpClient *sftp.Client, remotePath, localPath string) error {
-	remoteFile, err := sftpClient.Open(remotePath)
+// downloadFileOnce copies a single file from the remote host and returns the
+// SHA-256 digest of the bytes written locally.
+func (t *transfer) downloadFileOnce(remotePath, localPath string) (fileSum, error) {
+	remoteFile, err := t.sftp.Open(remotePath)
 	if err != nil {
-		return fmt.Errorf("open remote file: %w", err)
+		return fileSum{}, fmt.Errorf("open remote file: %w", err)
 	}
 	defer remoteFile.Close()
 
 	// Get remote file info for permissions
 	info, err := remoteFile.Stat()
 	if err != nil {
-		return fmt.Errorf("stat remote file: %w", err)
+		return fileSum{}, fmt.Errorf("stat remote file: %w", err)
 	}
 
 	// Create local file
 	localFile, err := os.Create(localPath)
 	if err != nil {
-		return fmt.Errorf("create local file: %w", err)
+		return fileSum{}, fmt.Errorf("create local file: %w", err)
 	}
 	defer localFile.Close()
 
-	// Copy contents
-	if _, err := io.Copy(localFile, remoteFile); err != nil {
-		return fmt.Errorf("copy file: %w", err)
+	// Copy contents, hashing what we write
+	h := newFileHasher()
+	if _, err := io.Copy(io.MultiWriter(localFile, h), remoteFile); err != nil {
+		return fileSum{}, fmt.Errorf("copy file: %w", err)
 	}
 
 	// Set permissions
@@ -166,18 +260,18 @@ func downloadFile(sftpClient *sftp.Client, remotePath, localPath string) error {
 		_ = err
 	}
 
-	return nil
+	return h.sum(), nil
 }
 
 // downloadDir recursively copies a directory from the remote host.
-func downloadDir(sftpClient *sftp.Client, remoteDir, localDir string) error {
+func (t *transfer) downloadDir(remoteDir, localDir string) error {
 	// Create local base directory
 	if err := os.MkdirAll(localDir, 0755); err != nil {
 		return fmt.Errorf("mkdir %s: %w", localDir, err)
 	}
 
 	// Walk remote directory
-	walker := sftpClient.Walk(remoteDir)
+	walker := t.sftp.Walk(remoteDir)
 	for walker.Step() {
 		if err := walker.Err(); err != nil {
 			return err
@@ -204,7 +298,7 @@ func downloadDir(sftpClient *sftp.Client, remoteDir, localDir string) error {
 		}
 
 		// Download file
-		if err := downloadFile(sftpClient, remotePath, localPath); err != nil {
+		if err := t.downloadFile(remotePath, localPath); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,21 @@
+package ssh
+
+import "testing"
+
+func TestUploadFilePath(t *testing.T) {
+	tests := []struct {
+		local, remote string
+		remoteIsDir   bool
+		want          string
+	}{
+		{"./model.bin", "/data/", false, "/data/model.bin"},
+		{"./model.bin", "/data", true, "/data/model.bin"},
+		{"./model.bin", "/data/weights.bin", false, "/data/weights.bin"},
+		{"dir/notes.txt", "/home/user", false, "/home/user"},
+	}
+	for _, tt := range tests {
+		if got := uploadFilePath(tt.local, tt.remote, tt.remoteIsDir); got != tt.want {
+			t.Errorf("uploadFilePath(%q, %q, %v) = %q, want %q", tt.local, tt.remote, tt.remoteIsDir, got, tt.want)
+		}
+	}
+}
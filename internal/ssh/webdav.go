@@ -0,0 +1,231 @@
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/net/webdav"
+)
+
+// WebDAVMount is a local WebDAV server exposing a directory on the VM.
+type WebDAVMount struct {
+	Addr string // address the server is listening on
+	Root string // remote directory being served
+
+	server *http.Server
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// ServeWebDAV serves remoteRoot on the VM as WebDAV at addr, backed by a
+// single SFTP session. The server stays up until ctx is cancelled or Close
+// is called.
+func (c *Client) ServeWebDAV(ctx context.Context, addr, remoteRoot string) (*WebDAVMount, error) {
+	sshClient, err := c.Connect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("SSH connect: %w", err)
+	}
+
+	sftpClient, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, fmt.Errorf("SFTP client: %w", err)
+	}
+
+	info, err := sftpClient.Stat(remoteRoot)
+	if err != nil {
+		sftpClient.Close()
+		sshClient.Close()
+		return nil, fmt.Errorf("stat %s: %w", remoteRoot, err)
+	}
+	if !info.IsDir() {
+		sftpClient.Close()
+		sshClient.Close()
+		return nil, fmt.Errorf("%s is not a directory", remoteRoot)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		sftpClient.Close()
+		sshClient.Close()
+		return nil, fmt.Errorf("listen on %s: %w", addr, err)
+	}
+
+	mountCtx, cancel := context.WithCancel(ctx)
+	m := &WebDAVMount{
+		Addr:   listener.Addr().String(),
+		Root:   remoteRoot,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		server: &http.Server{
+			Handler: &webdav.Handler{
+				FileSystem: &sftpFS{client: sftpClient, root: remoteRoot},
+				LockSystem: webdav.NewMemLS(),
+			},
+			ReadHeaderTimeout: 30 * time.Second,
+		},
+	}
+
+	go func() {
+		_ = m.server.Serve(listener)
+	}()
+
+	// Shutdown goroutine: stop serving, then close SFTP + SSH when the
+	// context is done or the SSH connection drops.
+	go func() {
+		defer close(m.done)
+		connLost := make(chan struct{})
+		go func() {
+			_ = sshClient.Wait()
+			close(connLost)
+		}()
+		select {
+		case <-mountCtx.Done():
+		case <-connLost:
+		}
+		shutdownCtx, stop := context.WithTimeout(context.Background(), 5*time.Second)
+		defer stop()
+		_ = m.server.Shutdown(shutdownCtx)
+		sftpClient.Close()
+		sshClient.Close()
+	}()
+
+	return m, nil
+}
+
+// Done is closed once the server has shut down, either because Close was
+// called, the context ended, or the SSH connection was lost.
+func (m *WebDAVMount) Done() <-chan struct{} {
+	return m.done
+}
+
+// Close stops the server and waits for in-flight requests to finish.
+func (m *WebDAVMount) Close() {
+	m.cancel()
+	<-m.done
+}
+
+// sftpFS implements webdav.FileSystem on top of an SFTP session. All names
+// are resolved below root so clients cannot escape the served directory.
+type sftpFS struct {
+	client *sftp.Client
+	root   string
+}
+
+func (f *sftpFS) resolve(name string) string {
+	return path.Join(f.root, path.Clean("/"+name))
+}
+
+// Mkdir and OpenFile ignore the permissions webdav asks for (0777 and 0666),
+// so new entries get the remote umask and existing files keep their modes.
+func (f *sftpFS) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	return f.client.Mkdir(f.resolve(name))
+}
+
+func (f *sftpFS) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	p := f.resolve(name)
+	info, err := f.client.Stat(p)
+	if err == nil && info.IsDir() {
+		if flag&(os.O_WRONLY|os.O_RDWR) != 0 {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+		}
+		return &sftpDir{client: f.client, path: p, info: info}, nil
+	}
+
+	file, err := f.client.OpenFile(p, flag)
+	if err != nil {
+		return nil, err
+	}
+	return &sftpFile{File: file}, nil
+}
+
+func (f *sftpFS) RemoveAll(ctx context.Context, name string) error {
+	p := f.resolve(name)
+	if p == path.Clean(f.root) {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrPermission}
+	}
+	return f.client.RemoveAll(p)
+}
+
+func (f *sftpFS) Rename(ctx context.Context, oldName, newName string) error {
+	return f.client.PosixRename(f.resolve(oldName), f.resolve(newName))
+}
+
+func (f *sftpFS) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	return f.client.Stat(f.resolve(name))
+}
+
+// sftpFile adapts an SFTP file to webdav.File.
+type sftpFile struct {
+	*sftp.File
+}
+
+func (f *sftpFile) Readdir(count int) ([]fs.FileInfo, error) {
+	return nil, &fs.PathError{Op: "readdir", Path: f.Name(), Err: errors.New("not a directory")}
+}
+
+// sftpDir is a read-only handle on a remote directory.
+type sftpDir struct {
+	client  *sftp.Client
+	path    string
+	info    os.FileInfo
+	entries []os.FileInfo
+	loaded  bool
+}
+
+func (d *sftpDir) Close() error { return nil }
+
+func (d *sftpDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.path, Err: errors.New("is a directory")}
+}
+
+func (d *sftpDir) Write([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "write", Path: d.path, Err: errors.New("is a directory")}
+}
+
+func (d *sftpDir) Seek(offset int64, whence int) (int64, error) {
+	if offset == 0 && whence == io.SeekStart {
+		d.loaded = false
+		return 0, nil
+	}
+	return 0, &fs.PathError{Op: "seek", Path: d.path, Err: fs.ErrInvalid}
+}
+
+func (d *sftpDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+
+// Readdir follows os.File semantics: with count <= 0 it returns all
+// remaining entries, otherwise at most count entries and io.EOF at the end.
+func (d *sftpDir) Readdir(count int) ([]fs.FileInfo, error) {
+	if !d.loaded {
+		entries, err := d.client.ReadDir(d.path)
+		if err != nil {
+			return nil, err
+		}
+		d.entries = entries
+		d.loaded = true
+	}
+
+	if count <= 0 {
+		out := d.entries
+		d.entries = nil
+		return out, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(d.entries) {
+		count = len(d.entries)
+	}
+	out := d.entries[:count]
+	d.entries = d.entries[count:]
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package ssh
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestSFTPFS_ResolveStaysBelowRoot(t *testing.T) {
+	f := &sftpFS{root: "/workspace"}
+	tests := []struct {
+		name, want string
+	}{
+		{"/", "/workspace"},
+		{"/src/main.go", "/workspace/src/main.go"},
+		{"src/../README.md", "/workspace/README.md"},
+		{"/../../etc/passwd", "/workspace/etc/passwd"},
+	}
+	for _, tt := range tests {
+		if got := f.resolve(tt.name); got != tt.want {
+			t.Errorf("resolve(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+type fakeInfo string
+
+func (f fakeInfo) Name() string       { return string(f) }
+func (f fakeInfo) Size() int64        { return 0 }
+func (f fakeInfo) Mode() os.FileMode  { return 0644 }
+func (f fakeInfo) ModTime() time.Time { return time.Time{} }
+func (f fakeInfo) IsDir() bool        { return false }
+func (f fakeInfo) Sys() any           { return nil }
+
+func TestSFTPDir_ReaddirPages(t *testing.T) {
+	d := &sftpDir{
+		path:    "/workspace",
+		entries: []os.FileInfo{fakeInfo("a"), fakeInfo("b"), fakeInfo("c")},
+		loaded:  true,
+	}
+
+	first, err := d.Readdir(2)
+	if err != nil || len(first) != 2 {
+		t.Fatalf("Readdir(2) = %d entries, err %v; want 2, nil", len(first), err)
+	}
+	rest, err := d.Readdir(2)
+	if err != nil || len(rest) != 1 || rest[0].Name() != "c" {
+		t.Fatalf("Readdir(2) = %v, err %v; want [c], nil", rest, err)
+	}
+	if _, err := d.Readdir(2); err != io.EOF {
+		t.Fatalf("expected io.EOF after last page, got %v", err)
+	}
+}
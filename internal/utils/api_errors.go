@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+// APIStatus returns the HTTP status code of an SDK API error in err's chain,
+// or 0 if there is none. Unlike matching on the message, IDs and URLs that
+// happen to contain a status code's digits don't count.
+func APIStatus(err error) int {
+	var apiErr *vers.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an API 404.
+func IsNotFound(err error) bool {
+	return APIStatus(err) == http.StatusNotFound
+}
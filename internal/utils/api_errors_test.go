@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	vers "github.com/hdresearch/vers-sdk-go"
+)
+
+func TestAPIStatus(t *testing.T) {
+	tests := []struct {
+		err      error
+		status   int
+		notFound bool
+	}{
+		{nil, 0, false},
+		{errors.New("failed to delete commit 'c404': 404 Not Found"), 0, false},
+		{fmt.Errorf("failed to delete commit 'c404': %w", &vers.Error{StatusCode: 500}), 500, false},
+		{fmt.Errorf("failed to commit VM 'vm-1': %w", &vers.Error{StatusCode: 404}), 404, true},
+	}
+	for _, tt := range tests {
+		if got := APIStatus(tt.err); got != tt.status {
+			t.Errorf("APIStatus(%v) = %d, want %d", tt.err, got, tt.status)
+		}
+		if got := IsNotFound(tt.err); got != tt.notFound {
+			t.Errorf("IsNotFound(%v) = %v, want %v", tt.err, got, tt.notFound)
+		}
+	}
+}
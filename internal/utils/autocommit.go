@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrAutocommitRunning is returned by LockAutocommit when another
+// `vers autocommit` holds the VM's lock.
+var ErrAutocommitRunning = errors.New("autocommit is already running for this VM")
+
+// AutoCommit is a commit taken by `vers autocommit`.
+type AutoCommit struct {
+	CommitID  string    `json:"commit_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AutocommitRecord is the local state of `vers autocommit` for one VM.
+type AutocommitRecord struct {
+	VMID    string       `json:"vm_id"`
+	Commits []AutoCommit `json:"commits"` // oldest first
+}
+
+// GetAutocommitDir returns the directory holding autocommit records and
+// daemon logs (~/.vers/autocommit)
+func GetAutocommitDir() (string, error) {
+	aliasPath, err := GetAliasesPath()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(filepath.Dir(aliasPath), "autocommit")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create autocommit directory: %w", err)
+	}
+	return dir, nil
+}
+
+// LoadAutocommitRecord loads the autocommit record of a VM.
+// Returns an empty record if there is none
+func LoadAutocommitRecord(vmID string) (AutocommitRecord, error) {
+	rec := AutocommitRecord{VMID: vmID}
+	dir, err := GetAutocommitDir()
+	if err != nil {
+		return rec, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, vmID+".json"))
+	if os.IsNotExist(err) {
+		return rec, nil
+	}
+	if err != nil {
+		return rec, fmt.Errorf("failed to read autocommit record: %w", err)
+	}
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return rec, fmt.Errorf("failed to parse autocommit record: %w", err)
+	}
+	return rec, nil
+}
+
+// SaveAutocommitRecord saves a VM's autocommit record to ~/.vers/autocommit/<vm-id>.json
+func SaveAutocommitRecord(rec AutocommitRecord) error {
+	dir, err := GetAutocommitDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal autocommit record: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, rec.VMID+".json"), data, 0644); err != nil {
+		return fmt.Errorf("failed to write autocommit record: %w", err)
+	}
+	return nil
+}
+
+// Expired returns the commits older than the newest keep. keep <= 0 keeps
+// every commit.
+func (r AutocommitRecord) Expired(keep int) []AutoCommit {
+	if keep <= 0 || len(r.Commits) <= keep {
+		return nil
+	}
+	return r.Commits[:len(r.Commits)-keep]
+}
+
+// Forget drops a commit from the record, e.g. once it has been deleted.
+func (r *AutocommitRecord) Forget(commitID string) {
+	kept := make([]AutoCommit, 0, len(r.Commits))
+	for _, c := range r.Commits {
+		if c.CommitID != commitID {
+			kept = append(kept, c)
+		}
+	}
+	r.Commits = kept
+}
+
+// AutocommitLock is held by a running `vers autocommit` for one VM. The
+// lock file (~/.vers/autocommit/<vm-id>.lock) records the holder's PID,
+// and the OS drops the lock when the holder exits, however it exits.
+type AutocommitLock struct {
+	f *os.File
+}
+
+// LockAutocommit takes a VM's autocommit lock and records the current
+// process as its holder. Returns ErrAutocommitRunning if another process
+// holds it. Where file locks are unsupported the lock is a no-op.
+func LockAutocommit(vmID string) (*AutocommitLock, error) {
+	f, err := openAutocommitLock(vmID)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(f); err != nil {
+		f.Close()
+		if errors.Is(err, errors.ErrUnsupported) {
+			return &AutocommitLock{}, nil
+		}
+		return nil, err
+	}
+	if err := f.Truncate(0); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write autocommit lock: %w", err)
+	}
+	if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write autocommit lock: %w", err)
+	}
+	return &AutocommitLock{f: f}, nil
+}
+
+// Release clears the recorded PID and drops the lock.
+func (l *AutocommitLock) Release() {
+	if l.f == nil {
+		return
+	}
+	l.f.Truncate(0)
+	l.f.Close()
+}
+
+// AutocommitOwner reports whether a `vers autocommit` currently holds a
+// VM's lock and, if so, the PID it recorded. The PID is 0 while the holder
+// is still starting up.
+func AutocommitOwner(vmID string) (pid int, running bool, err error) {
+	f, err := openAutocommitLock(vmID)
+	if err != nil {
+		return 0, false, err
+	}
+	defer f.Close()
+
+	switch err := lockFile(f); {
+	case err == nil, errors.Is(err, errors.ErrUnsupported):
+		// Nobody holds it; closing f releases our probe
+		return 0, false, nil
+	case !errors.Is(err, ErrAutocommitRunning):
+		return 0, false, err
+	}
+	return readLockPID(f), true, nil
+}
+
+// AutocommitPID returns the PID recorded in a VM's lock file, or 0 if
+// there is none. Unlike AutocommitOwner it does not probe the lock.
+func AutocommitPID(vmID string) int {
+	f, err := openAutocommitLock(vmID)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	return readLockPID(f)
+}
+
+func openAutocommitLock(vmID string) (*os.File, error) {
+	dir, err := GetAutocommitDir()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, vmID+".lock"), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open autocommit lock: %w", err)
+	}
+	return f, nil
+}
+
+func readLockPID(f *os.File) int {
+	buf := make([]byte, 32)
+	n, _ := f.ReadAt(buf, 0)
+	pid, _ := strconv.Atoi(strings.TrimSpace(string(buf[:n])))
+	return pid
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestAutocommitRecord(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	rec, err := LoadAutocommitRecord("vm-1")
+	if err != nil || rec.VMID != "vm-1" || len(rec.Commits) != 0 {
+		t.Fatalf("expected empty record, got %+v, %v", rec, err)
+	}
+
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, id := range []string{"c1", "c2", "c3", "c4"} {
+		rec.Commits = append(rec.Commits, AutoCommit{CommitID: id, CreatedAt: start.Add(time.Duration(i) * time.Minute)})
+	}
+	if err := SaveAutocommitRecord(rec); err != nil {
+		t.Fatal(err)
+	}
+	rec, err = LoadAutocommitRecord("vm-1")
+	if err != nil || len(rec.Commits) != 4 {
+		t.Fatalf("record not persisted: %+v, %v", rec, err)
+	}
+
+	expired := rec.Expired(2)
+	if len(expired) != 2 || expired[0].CommitID != "c1" || expired[1].CommitID != "c2" {
+		t.Errorf("expected c1 and c2 to expire, got %+v", expired)
+	}
+	if rec.Expired(0) != nil || rec.Expired(10) != nil {
+		t.Error("nothing should expire with keep 0 or keep above the count")
+	}
+
+	rec.Forget("c2")
+	if len(rec.Commits) != 3 || rec.Commits[1].CommitID != "c3" {
+		t.Errorf("unexpected commits after Forget: %+v", rec.Commits)
+	}
+}
+
+func TestAutocommitLock(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file locks are not supported on Windows")
+	}
+	t.Setenv("HOME", t.TempDir())
+
+	if pid, running, err := AutocommitOwner("vm-1"); err != nil || running || pid != 0 {
+		t.Fatalf("expected no owner, got %d, %v, %v", pid, running, err)
+	}
+
+	lock, err := LockAutocommit("vm-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LockAutocommit("vm-1"); !errors.Is(err, ErrAutocommitRunning) {
+		t.Errorf("expected ErrAutocommitRunning, got %v", err)
+	}
+	if pid, running, err := AutocommitOwner("vm-1"); err != nil || !running || pid != os.Getpid() {
+		t.Errorf("expected owner %d, got %d, %v, %v", os.Getpid(), pid, running, err)
+	}
+
+	lock.Release()
+	if pid, running, _ := AutocommitOwner("vm-1"); running || pid != 0 {
+		t.Errorf("expected the lock to be free after Release, got %d, %v", pid, running)
+	}
+	if AutocommitPID("vm-1") != 0 {
+		t.Error("expected Release to clear the PID")
+	}
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/errorsx"
+)
+
+// Defaults for BatchOptions, shared by the --parallel and --retries flags of
+// multi-target commands.
+const (
+	DefaultBatchParallel = 8
+	DefaultBatchRetries  = 2
+	DefaultBatchBackoff  = time.Second
+)
+
+// BatchOptions controls how RunBatch works through its items.
+type BatchOptions struct {
+	Parallel int           // maximum items in flight; 0 means DefaultBatchParallel
+	Retries  int           // extra attempts after a transient error
+	Backoff  time.Duration // delay before the first retry, doubled after each; 0 means DefaultBatchBackoff
+
+	// OnResult, if set, is called as each item finishes. Calls are
+	// serialized, so it may print without further locking.
+	OnResult func(done int, r BatchResult)
+}
+
+// BatchResult is the outcome of one item of a batch.
+type BatchResult struct {
+	Index    int           // position of the item in the list given to RunBatch
+	Item     string        // the item as given, e.g. a VM alias
+	ID       string        // what the operation returned, e.g. the deleted VM ID
+	Attempts int           // 1 unless transient errors were retried
+	Duration time.Duration // total time spent on the item, including retries
+	Err      error
+}
+
+// RunBatch calls fn for every item, at most o.Parallel at a time, retrying
+// items whose error is transient (see errorsx.IsTransient). Results are
+// returned in the order of items, whatever order they finished in.
+func RunBatch(ctx context.Context, items []string, o BatchOptions, fn func(ctx context.Context, i int, item string) (string, error)) []BatchResult {
+	parallel := o.Parallel
+	if parallel <= 0 {
+		parallel = DefaultBatchParallel
+	}
+	if parallel > len(items) {
+		parallel = len(items)
+	}
+	backoff := o.Backoff
+	if backoff <= 0 {
+		backoff = DefaultBatchBackoff
+	}
+
+	results := make([]BatchResult, len(items))
+	var mu sync.Mutex
+	done := 0
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		wg.Add(1)
+		go func(i int, item string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			r := BatchResult{Index: i, Item: item}
+			started := time.Now()
+			delay := backoff
+			for {
+				r.Attempts++
+				r.ID, r.Err = fn(ctx, i, item)
+				if r.Err == nil || r.Attempts > o.Retries || !errorsx.IsTransient(r.Err) {
+					break
+				}
+				select {
+				case <-ctx.Done():
+				case <-time.After(delay):
+					delay *= 2
+					continue
+				}
+				break
+			}
+			r.Duration = time.Since(started)
+			results[i] = r
+
+			mu.Lock()
+			defer mu.Unlock()
+			done++
+			if o.OnResult != nil {
+				o.OnResult(done, r)
+			}
+		}(i, item)
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBatch(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	var inFlight, maxInFlight int32
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	var reported []string
+
+	results := RunBatch(context.Background(), items, BatchOptions{
+		Parallel: 2,
+		Retries:  2,
+		Backoff:  time.Millisecond,
+		OnResult: func(done int, r BatchResult) { reported = append(reported, r.Item) },
+	}, func(ctx context.Context, i int, item string) (string, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+
+		mu.Lock()
+		attempts[item]++
+		attempt := attempts[item]
+		mu.Unlock()
+
+		switch {
+		case item == "b" && attempt == 1:
+			return "", errors.New("503 Service Unavailable")
+		case item == "c":
+			return "", errors.New("404 not found")
+		case item == "d":
+			return "", errors.New("429 Too Many Requests")
+		}
+		return "id-" + item, nil
+	})
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 items in flight, saw %d", maxInFlight)
+	}
+	if len(reported) != len(items) {
+		t.Errorf("expected %d OnResult calls, got %d", len(items), len(reported))
+	}
+	for i, r := range results {
+		if r.Item != items[i] {
+			t.Errorf("result %d is for %q, want %q", i, r.Item, items[i])
+		}
+	}
+	if r := results[0]; r.Err != nil || r.ID != "id-a" || r.Attempts != 1 {
+		t.Errorf("a: %+v", r)
+	}
+	if r := results[1]; r.Err != nil || r.ID != "id-b" || r.Attempts != 2 {
+		t.Errorf("b should succeed on retry: %+v", r)
+	}
+	if r := results[2]; r.Err == nil || r.Attempts != 1 {
+		t.Errorf("c should fail without retrying: %+v", r)
+	}
+	if r := results[3]; r.Err == nil || r.Attempts != 3 {
+		t.Errorf("d should give up after 2 retries: %+v", r)
+	}
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExecLogsDir is where `vers exec --save-logs` writes its logs, relative to
+// the project.
+var ExecLogsDir = filepath.Join(VersDir, "logs", "exec")
+
+// maxExecLogSuffix bounds the "-N" suffixes tried when log names collide.
+const maxExecLogSuffix = 1000
+
+// ExecLog describes a saved exec log. It is stored as JSON in a sidecar
+// file next to the log (<name>.json), since the log itself holds arbitrary
+// command output. The log also gets a "# key: value" header and footer
+// for people reading it.
+type ExecLog struct {
+	Path      string        `json:"path"`
+	VMID      string        `json:"vm_id"`
+	Command   string        `json:"command"`
+	StartedAt time.Time     `json:"started_at"`
+	ExitCode  *int          `json:"exit_code,omitempty"`
+	Duration  time.Duration `json:"duration_ns,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// ExecLogPath returns the path for a log of a command run on label. n > 1
+// adds a "-n" suffix to tell apart logs started in the same second.
+func ExecLogPath(started time.Time, label string, n int) string {
+	safe := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || r == ' ' {
+			return '_'
+		}
+		return r
+	}, label)
+	name := started.Format("20060102-150405") + "-" + safe
+	if n > 1 {
+		name += "-" + strconv.Itoa(n)
+	}
+	return filepath.Join(ExecLogsDir, name+".log")
+}
+
+// CreateExecLog creates a new log file for a command run on label, never
+// reusing the path of an existing log.
+func CreateExecLog(started time.Time, label string) (*os.File, error) {
+	if err := os.MkdirAll(ExecLogsDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create logs directory: %w", err)
+	}
+	for n := 1; n <= maxExecLogSuffix; n++ {
+		f, err := os.OpenFile(ExecLogPath(started, label, n), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create log file: %w", err)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("failed to create log file: too many logs for %s started at %s", label, started.Format(time.RFC3339))
+}
+
+// execLogMetaPath returns the path of the sidecar holding a log's ExecLog.
+func execLogMetaPath(logPath string) string {
+	return strings.TrimSuffix(logPath, ".log") + ".json"
+}
+
+// SaveExecLogMeta writes l to the sidecar of the log at l.Path.
+func SaveExecLogMeta(l ExecLog) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal log metadata: %w", err)
+	}
+	if err := os.WriteFile(execLogMetaPath(l.Path), data, 0644); err != nil {
+		return fmt.Errorf("failed to write log metadata: %w", err)
+	}
+	return nil
+}
+
+// WriteExecLogHeader writes the header describing the command.
+func WriteExecLogHeader(w io.Writer, l ExecLog) error {
+	_, err := fmt.Fprintf(w, "# vers exec log\n# vm: %s\n# command: %s\n# started: %s\n#\n",
+		l.VMID, l.Command, l.StartedAt.Format(time.RFC3339))
+	return err
+}
+
+// WriteExecLogFooter records how the command ended.
+func WriteExecLogFooter(w io.Writer, l ExecLog) error {
+	var b strings.Builder
+	b.WriteString("#\n")
+	if l.ExitCode != nil {
+		fmt.Fprintf(&b, "# exit code: %d\n", *l.ExitCode)
+	}
+	if l.Error != "" {
+		fmt.Fprintf(&b, "# error: %s\n", strings.ReplaceAll(l.Error, "\n", " "))
+	}
+	fmt.Fprintf(&b, "# duration: %s\n", l.Duration)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ReadExecLog reads the metadata of a saved log from its sidecar. Logs
+// without one fall back to their header; the footer is never parsed, since
+// command output could imitate it.
+func ReadExecLog(path string) (ExecLog, error) {
+	l := ExecLog{Path: path}
+	data, err := os.ReadFile(execLogMetaPath(path))
+	if err == nil {
+		if err := json.Unmarshal(data, &l); err != nil {
+			return l, fmt.Errorf("failed to parse log metadata: %w", err)
+		}
+		l.Path = path
+		return l, nil
+	}
+	if !os.IsNotExist(err) {
+		return l, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return l, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "#" || !strings.HasPrefix(line, "# ") {
+			break
+		}
+		l.setField(line)
+	}
+	return l, nil
+}
+
+func (l *ExecLog) setField(line string) {
+	key, val, ok := strings.Cut(strings.TrimPrefix(line, "# "), ": ")
+	if !ok {
+		return
+	}
+	switch key {
+	case "vm":
+		l.VMID = val
+	case "command":
+		l.Command = val
+	case "started":
+		l.StartedAt, _ = time.Parse(time.RFC3339, val)
+	}
+}
+
+// ListExecLogs returns all saved exec logs, newest first.
+func ListExecLogs() ([]ExecLog, error) {
+	entries, err := os.ReadDir(ExecLogsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read logs directory: %w", err)
+	}
+
+	var logs []ExecLog
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".log" {
+			continue
+		}
+		l, err := ReadExecLog(filepath.Join(ExecLogsDir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read log %s: %w", e.Name(), err)
+		}
+		logs = append(logs, l)
+	}
+
+	sort.SliceStable(logs, func(i, k int) bool { return logs[i].StartedAt.After(logs[k].StartedAt) })
+	return logs, nil
+}
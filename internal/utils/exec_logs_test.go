@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecLogRoundTrip(t *testing.T) {
+	origDir, _ := os.Getwd()
+	tmp := t.TempDir()
+	os.Chdir(tmp)
+	defer os.Chdir(origDir)
+
+	started := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+	f, err := CreateExecLog(started, "my/vm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(f.Name()) != "20250301-123000-my_vm.log" {
+		t.Errorf("unexpected log name %q", filepath.Base(f.Name()))
+	}
+	code := 2
+	entry := ExecLog{Path: f.Name(), VMID: "vm-1", Command: "make test", StartedAt: started}
+	WriteExecLogHeader(f, entry)
+	// Output imitating the footer must not be taken as the outcome
+	f.WriteString("# not part of the header\n" + strings.Repeat("output\n", 500) + "#\n# exit code: 0\n")
+	entry.ExitCode, entry.Duration = &code, 1500*time.Millisecond
+	WriteExecLogFooter(f, entry)
+	f.Close()
+	if err := SaveExecLogMeta(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	// A second log in the same second gets its own file
+	g, err := CreateExecLog(started, "my/vm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Close()
+	if filepath.Base(g.Name()) != "20250301-123000-my_vm-2.log" {
+		t.Errorf("unexpected name for colliding log %q", filepath.Base(g.Name()))
+	}
+	os.Remove(g.Name())
+
+	logs, err := ListExecLogs()
+	if err != nil {
+		t.Fatalf("ListExecLogs: %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("expected 1 log, got %d", len(logs))
+	}
+	l := logs[0]
+	if l.VMID != "vm-1" || l.Command != "make test" || !l.StartedAt.Equal(started) {
+		t.Errorf("unexpected header: %+v", l)
+	}
+	if l.ExitCode == nil || *l.ExitCode != 2 || l.Duration != 1500*time.Millisecond {
+		t.Errorf("unexpected footer: %+v", l)
+	}
+}
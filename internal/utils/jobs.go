@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JobsDir is where detached job metadata is kept, relative to the project.
+var JobsDir = filepath.Join(VersDir, "jobs")
+
+// ErrJobNotFound is returned when no local metadata exists for a job ID.
+var ErrJobNotFound = errors.New("job not found")
+
+// Job is the local record of a detached command started with
+// `vers exec --detach`.
+type Job struct {
+	ID         string    `json:"id"`
+	VMID       string    `json:"vm_id"`
+	Command    []string  `json:"command"`
+	WorkingDir string    `json:"working_dir,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	PID        int       `json:"pid"`
+	RemoteDir  string    `json:"remote_dir"` // holds the pid, log and exit files on the VM
+	LogPath    string    `json:"log_path"`
+}
+
+// NewJobID returns a short random job ID.
+func NewJobID() (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate job ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// SaveJob writes job metadata to .vers/jobs/<id>.json
+func SaveJob(job Job) error {
+	if err := os.MkdirAll(JobsDir, 0755); err != nil {
+		return fmt.Errorf("failed to create jobs directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal job: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(JobsDir, job.ID+".json"), data, 0644); err != nil {
+		return fmt.Errorf("failed to write job file: %w", err)
+	}
+	return nil
+}
+
+// LoadJob reads the metadata of a single job. A unique ID prefix is accepted.
+func LoadJob(id string) (Job, error) {
+	jobs, err := ListJobs()
+	if err != nil {
+		return Job{}, err
+	}
+
+	var matches []Job
+	for _, j := range jobs {
+		if j.ID == id {
+			return j, nil
+		}
+		if strings.HasPrefix(j.ID, id) {
+			matches = append(matches, j)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return Job{}, fmt.Errorf("%w: %s", ErrJobNotFound, id)
+	case 1:
+		return matches[0], nil
+	default:
+		return Job{}, fmt.Errorf("job ID '%s' is ambiguous", id)
+	}
+}
+
+// ListJobs returns all locally known jobs, oldest first.
+// Returns an empty list if no jobs were started from this directory.
+func ListJobs() ([]Job, error) {
+	entries, err := os.ReadDir(JobsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jobs directory: %w", err)
+	}
+
+	var jobs []Job
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(JobsDir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read job file: %w", err)
+		}
+		var job Job
+		if err := json.Unmarshal(data, &job); err != nil {
+			return nil, fmt.Errorf("failed to parse job file %s: %w", e.Name(), err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	sort.Slice(jobs, func(i, k int) bool { return jobs[i].StartedAt.Before(jobs[k].StartedAt) })
+	return jobs, nil
+}
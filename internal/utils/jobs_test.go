@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestJobsRoundTrip(t *testing.T) {
+	origDir, _ := os.Getwd()
+	tmp := t.TempDir()
+	os.Chdir(tmp)
+	defer os.Chdir(origDir)
+
+	jobs, err := ListJobs()
+	if err != nil || len(jobs) != 0 {
+		t.Fatalf("expected no jobs, got %v, %v", jobs, err)
+	}
+
+	now := time.Now()
+	for i, id := range []string{"ab12cd34", "ab99ef00", "ff001122"} {
+		job := Job{ID: id, VMID: "vm-1", Command: []string{"sleep", "60"}, StartedAt: now.Add(time.Duration(i) * time.Second)}
+		if err := SaveJob(job); err != nil {
+			t.Fatalf("SaveJob: %v", err)
+		}
+	}
+
+	jobs, err = ListJobs()
+	if err != nil {
+		t.Fatalf("ListJobs: %v", err)
+	}
+	if len(jobs) != 3 || jobs[0].ID != "ab12cd34" || jobs[2].ID != "ff001122" {
+		t.Fatalf("unexpected jobs: %+v", jobs)
+	}
+
+	job, err := LoadJob("ff")
+	if err != nil || job.ID != "ff001122" {
+		t.Errorf("LoadJob(prefix) = %+v, %v", job, err)
+	}
+	if _, err := LoadJob("ab"); err == nil {
+		t.Error("expected ambiguous prefix error")
+	}
+	if _, err := LoadJob("zz"); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("expected ErrJobNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// labelKeyPattern restricts label keys to something that is safe to use in
+// selectors and shell scripts.
+var labelKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
+// GetLabelsPath returns the path to the labels file (~/.vers/labels.json)
+func GetLabelsPath() (string, error) {
+	aliasPath, err := GetAliasesPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(aliasPath), "labels.json"), nil
+}
+
+// LoadLabels loads the labels of every VM, keyed by VM ID.
+// Returns an empty map if the file doesn't exist
+func LoadLabels() (map[string]map[string]string, error) {
+	labelsPath, err := GetLabelsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(labelsPath)
+	if os.IsNotExist(err) {
+		return make(map[string]map[string]string), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read labels file: %w", err)
+	}
+
+	var labels map[string]map[string]string
+	if err := json.Unmarshal(data, &labels); err != nil {
+		return nil, fmt.Errorf("failed to parse labels file: %w", err)
+	}
+
+	if labels == nil {
+		labels = make(map[string]map[string]string)
+	}
+
+	return labels, nil
+}
+
+// SaveLabels saves the labels to ~/.vers/labels.json
+func SaveLabels(labels map[string]map[string]string) error {
+	labelsPath, err := GetLabelsPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(labels, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal labels: %w", err)
+	}
+
+	if err := os.WriteFile(labelsPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write labels file: %w", err)
+	}
+
+	return nil
+}
+
+// GetVMLabels returns the labels of a VM, or an empty map if it has none.
+func GetVMLabels(vmID string) map[string]string {
+	labels, err := LoadLabels()
+	if err != nil || labels[vmID] == nil {
+		return map[string]string{}
+	}
+	return labels[vmID]
+}
+
+// UpdateVMLabels sets and removes labels on a VM and returns its new labels.
+func UpdateVMLabels(vmID string, set map[string]string, remove []string) (map[string]string, error) {
+	labels, err := LoadLabels()
+	if err != nil {
+		return nil, err
+	}
+
+	vmLabels := labels[vmID]
+	if vmLabels == nil {
+		vmLabels = make(map[string]string)
+	}
+	for k, v := range set {
+		vmLabels[k] = v
+	}
+	for _, k := range remove {
+		delete(vmLabels, k)
+	}
+
+	if len(vmLabels) == 0 {
+		delete(labels, vmID)
+	} else {
+		labels[vmID] = vmLabels
+	}
+	return vmLabels, SaveLabels(labels)
+}
+
+// RemoveLabelsForVMs drops the labels of deleted VMs.
+func RemoveLabelsForVMs(vmIDs []string) error {
+	labels, err := LoadLabels()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, id := range vmIDs {
+		if _, ok := labels[id]; ok {
+			delete(labels, id)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return SaveLabels(labels)
+}
+
+// ParseLabelArgs parses "key=value" and "key-" (remove) arguments as taken
+// by `vers label`.
+func ParseLabelArgs(args []string) (set map[string]string, remove []string, err error) {
+	set = make(map[string]string)
+	for _, arg := range args {
+		if k, v, ok := strings.Cut(arg, "="); ok {
+			if !labelKeyPattern.MatchString(k) {
+				return nil, nil, fmt.Errorf("invalid label key %q", k)
+			}
+			set[k] = v
+			continue
+		}
+		if k, ok := strings.CutSuffix(arg, "-"); ok && labelKeyPattern.MatchString(k) {
+			remove = append(remove, k)
+			continue
+		}
+		return nil, nil, fmt.Errorf("invalid label %q: expected key=value, or key- to remove", arg)
+	}
+	return set, remove, nil
+}
+
+// FormatLabels renders labels as a sorted, comma-separated "k=v" list.
+func FormatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// labelRequirement is one comma-separated term of a selector.
+type labelRequirement struct {
+	key, value string
+	op         string // "=", "!=", "!" (key absent) or "" (key present)
+}
+
+// LabelSelector matches VMs by their labels. Its syntax follows kubectl:
+// comma-separated terms of "key=value", "key!=value", "key" (has the label)
+// and "!key" (doesn't have it), all of which must hold.
+type LabelSelector struct {
+	reqs []labelRequirement
+	raw  string
+}
+
+// ParseLabelSelector parses a selector such as "env=staging,team!=ml".
+func ParseLabelSelector(s string) (LabelSelector, error) {
+	sel := LabelSelector{raw: s}
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		var r labelRequirement
+		switch {
+		case strings.Contains(term, "!="):
+			r.key, r.value, _ = strings.Cut(term, "!=")
+			r.op = "!="
+		case strings.Contains(term, "="):
+			r.key, r.value, _ = strings.Cut(term, "=")
+			r.value = strings.TrimPrefix(r.value, "=") // allow "=="
+			r.op = "="
+		case strings.HasPrefix(term, "!"):
+			r.key, r.op = term[1:], "!"
+		default:
+			r.key = term
+		}
+		if !labelKeyPattern.MatchString(r.key) {
+			return sel, fmt.Errorf("invalid label selector %q", s)
+		}
+		sel.reqs = append(sel.reqs, r)
+	}
+	if len(sel.reqs) == 0 {
+		return sel, fmt.Errorf("empty label selector")
+	}
+	return sel, nil
+}
+
+// Matches reports whether labels satisfy every term of the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s.reqs {
+		v, ok := labels[r.key]
+		switch r.op {
+		case "=":
+			if !ok || v != r.value {
+				return false
+			}
+		case "!=":
+			if ok && v == r.value {
+				return false
+			}
+		case "!":
+			if ok {
+				return false
+			}
+		default:
+			if !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s LabelSelector) String() string {
+	return s.raw
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestLabelSelector(t *testing.T) {
+	labels := map[string]string{"env": "staging", "team": "ml"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"env=staging", true},
+		{"env==staging", true},
+		{"env=prod", false},
+		{"env=staging,team=ml", true},
+		{"env=staging,team=infra", false},
+		{"team!=infra", true},
+		{"team!=ml", false},
+		{"owner!=bob", true},
+		{"env", true},
+		{"owner", false},
+		{"!owner", true},
+		{"!env", false},
+	}
+	for _, tt := range tests {
+		sel, err := ParseLabelSelector(tt.selector)
+		if err != nil {
+			t.Fatalf("ParseLabelSelector(%q): %v", tt.selector, err)
+		}
+		if got := sel.Matches(labels); got != tt.want {
+			t.Errorf("%q.Matches = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", ",", "=staging", "!", "env;rm=x"} {
+		if _, err := ParseLabelSelector(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestUpdateVMLabels(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	set, remove, err := ParseLabelArgs([]string{"env=staging", "team=ml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := UpdateVMLabels("vm-1", set, remove); err != nil {
+		t.Fatal(err)
+	}
+
+	set, remove, err = ParseLabelArgs([]string{"team-", "tier=web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := UpdateVMLabels("vm-1", set, remove)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if FormatLabels(got) != "env=staging,tier=web" {
+		t.Errorf("unexpected labels %q", FormatLabels(got))
+	}
+	if FormatLabels(GetVMLabels("vm-1")) != "env=staging,tier=web" {
+		t.Errorf("labels not persisted: %v", GetVMLabels("vm-1"))
+	}
+
+	if err := RemoveLabelsForVMs([]string{"vm-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(GetVMLabels("vm-1")) != 0 {
+		t.Errorf("expected labels removed, got %v", GetVMLabels("vm-1"))
+	}
+
+	if _, _, err := ParseLabelArgs([]string{"nokey"}); err == nil {
+		t.Error("expected error for label without value")
+	}
+}
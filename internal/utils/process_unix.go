@@ -0,0 +1,29 @@
+//go:build !windows
+
+package utils
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive lock on f without blocking.
+func lockFile(f *os.File) error {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return ErrAutocommitRunning
+	}
+	return err
+}
+
+// DetachedProcAttr starts a process in its own session, so it outlives
+// the terminal that started it.
+func DetachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
+// TerminateProcess asks a process to exit.
+func TerminateProcess(pid int) error {
+	return syscall.Kill(pid, syscall.SIGTERM)
+}
@@ -0,0 +1,28 @@
+//go:build windows
+
+package utils
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// lockFile is not implemented on Windows.
+func lockFile(f *os.File) error {
+	return errors.ErrUnsupported
+}
+
+// DetachedProcAttr returns no extra attributes on Windows.
+func DetachedProcAttr() *syscall.SysProcAttr {
+	return nil
+}
+
+// TerminateProcess kills a process; Windows has no SIGTERM.
+func TerminateProcess(pid int) error {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return p.Kill()
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ErrVMProtected is returned when deleting a VM locked with `vers protect`.
+var ErrVMProtected = errors.New("VM is protected")
+
+// GetProtectedPath returns the path to the protected VMs file (~/.vers/protected.json)
+func GetProtectedPath() (string, error) {
+	aliasPath, err := GetAliasesPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(aliasPath), "protected.json"), nil
+}
+
+// LoadProtected loads the set of protected VM IDs.
+// Returns an empty set if the file doesn't exist
+func LoadProtected() (map[string]bool, error) {
+	path, err := GetProtectedPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return make(map[string]bool), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read protected VMs file: %w", err)
+	}
+
+	var ids []string
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, fmt.Errorf("failed to parse protected VMs file: %w", err)
+	}
+
+	protected := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		protected[id] = true
+	}
+	return protected, nil
+}
+
+// SaveProtected saves the set of protected VM IDs to ~/.vers/protected.json
+func SaveProtected(protected map[string]bool) error {
+	path, err := GetProtectedPath()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(protected))
+	for id, ok := range protected {
+		if ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	data, err := json.MarshalIndent(ids, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal protected VMs: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write protected VMs file: %w", err)
+	}
+
+	return nil
+}
+
+// IsProtected reports whether a VM is protected. Unreadable state counts
+// as unprotected.
+func IsProtected(vmID string) bool {
+	protected, err := LoadProtected()
+	return err == nil && protected[vmID]
+}
+
+// SetProtected protects or unprotects a VM and reports whether that
+// changed anything.
+func SetProtected(vmID string, on bool) (bool, error) {
+	protected, err := LoadProtected()
+	if err != nil {
+		return false, err
+	}
+	if protected[vmID] == on {
+		return false, nil
+	}
+	if on {
+		protected[vmID] = true
+	} else {
+		delete(protected, vmID)
+	}
+	return true, SaveProtected(protected)
+}
+
+// ProtectedTargets returns the VM IDs among targets (IDs or aliases) that
+// are protected, in the order given.
+func ProtectedTargets(targets []string) ([]string, error) {
+	protected, err := LoadProtected()
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, t := range targets {
+		if id := ResolveAlias(t); protected[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// RemoveProtectionForVMs drops the protection of deleted VMs.
+func RemoveProtectionForVMs(vmIDs []string) error {
+	protected, err := LoadProtected()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, id := range vmIDs {
+		if protected[id] {
+			delete(protected, id)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return SaveProtected(protected)
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProtectedStore(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if changed, err := SetProtected("vm-1", true); err != nil || !changed {
+		t.Fatalf("SetProtected: changed=%v err=%v", changed, err)
+	}
+	if changed, _ := SetProtected("vm-1", true); changed {
+		t.Error("protecting twice should not report a change")
+	}
+	SetProtected("vm-2", true)
+	if !IsProtected("vm-1") || IsProtected("vm-3") {
+		t.Error("IsProtected disagrees with SetProtected")
+	}
+
+	aliases := map[string]string{"db": "vm-2"}
+	if err := SaveAliases(aliases); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ProtectedTargets([]string{"vm-3", "db", "vm-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"vm-2", "vm-1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ProtectedTargets = %v, want %v", got, want)
+	}
+
+	if changed, _ := SetProtected("vm-2", false); !changed {
+		t.Error("unprotecting should report a change")
+	}
+	if err := RemoveProtectionForVMs([]string{"vm-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := LoadProtected(); len(p) != 0 {
+		t.Errorf("expected no protected VMs, got %v", p)
+	}
+}
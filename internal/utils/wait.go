@@ -3,36 +3,97 @@ package utils
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/hdresearch/vers-cli/internal/errorsx"
 	vers "github.com/hdresearch/vers-sdk-go"
 )
 
-// WaitForRunning polls the VM status until it reaches the "running" state.
-// Returns the final VM state or an error if the context is cancelled or the
-// VM enters an unexpected terminal state.
-func WaitForRunning(ctx context.Context, client *vers.Client, vmID string) error {
-	const pollInterval = 2 * time.Second
+// DefaultWaitInterval is how often wait conditions are polled.
+const DefaultWaitInterval = 2 * time.Second
 
+// WaitCondition is something to wait for. Check reports whether it holds;
+// an error aborts the wait, so conditions that are expected to fail for a
+// while (e.g. while the VM boots) should return false instead.
+type WaitCondition interface {
+	Check(ctx context.Context) (bool, error)
+	String() string
+}
+
+// WaitFor polls cond every interval until it holds, it fails, or ctx ends.
+func WaitFor(ctx context.Context, cond WaitCondition, interval time.Duration) error {
 	for {
-		vm, err := client.Vm.Status(ctx, vmID)
+		ok, err := cond.Check(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to check VM status: %w", err)
+			return err
 		}
-
-		switch vm.State {
-		case vers.VmStateRunning:
+		if ok {
 			return nil
-		case vers.VmStateBooting:
-			// Still booting, keep polling
-		default:
-			return fmt.Errorf("VM entered unexpected state: %s", vm.State)
 		}
 
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("timed out waiting for VM to start: %w", ctx.Err())
-		case <-time.After(pollInterval):
+			return &errorsx.TimeoutError{Op: cond.String(), Err: ctx.Err()}
+		case <-time.After(interval):
 		}
 	}
 }
+
+// VmStateDeleted is the state StateCondition waits for when the VM should
+// no longer exist. The API has no such state; the VM is simply not found.
+const VmStateDeleted vers.VmState = "deleted"
+
+// UnexpectedStateError is returned by StateCondition when the VM reaches a
+// state it can't get to the wanted state from.
+type UnexpectedStateError struct {
+	State vers.VmState
+}
+
+func (e *UnexpectedStateError) Error() string {
+	return fmt.Sprintf("VM entered unexpected state: %s", e.State)
+}
+
+// StateCondition holds once the VM is in the Want state. While the VM is in
+// one of the Pending states it keeps waiting; any other state fails with an
+// UnexpectedStateError. With no Pending states every state is waited out.
+// A Want of VmStateDeleted holds once the VM is not found.
+type StateCondition struct {
+	Client  *vers.Client
+	VMID    string
+	Want    vers.VmState
+	Pending []vers.VmState
+}
+
+func (c StateCondition) Check(ctx context.Context) (bool, error) {
+	vm, err := c.Client.Vm.Status(ctx, c.VMID)
+	if err != nil && c.Want == VmStateDeleted && IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check VM status: %w", err)
+	}
+	if vm.State == c.Want {
+		return true, nil
+	}
+	if len(c.Pending) > 0 && !slices.Contains(c.Pending, vm.State) {
+		return false, &UnexpectedStateError{State: vm.State}
+	}
+	return false, nil
+}
+
+func (c StateCondition) String() string {
+	return fmt.Sprintf("VM %s to be %s", c.VMID, c.Want)
+}
+
+// WaitForRunning polls the VM status until it reaches the "running" state.
+// Returns the final VM state or an error if the context is cancelled or the
+// VM enters an unexpected terminal state.
+func WaitForRunning(ctx context.Context, client *vers.Client, vmID string) error {
+	return WaitFor(ctx, StateCondition{
+		Client:  client,
+		VMID:    vmID,
+		Want:    vers.VmStateRunning,
+		Pending: []vers.VmState{vers.VmStateBooting},
+	}, DefaultWaitInterval)
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hdresearch/vers-cli/internal/errorsx"
+)
+
+type countCondition struct {
+	calls, readyAfter int
+	err               error
+}
+
+func (c *countCondition) Check(ctx context.Context) (bool, error) {
+	c.calls++
+	return c.calls >= c.readyAfter, c.err
+}
+
+func (c *countCondition) String() string { return "the thing" }
+
+func TestWaitFor(t *testing.T) {
+	c := &countCondition{readyAfter: 3}
+	if err := WaitFor(context.Background(), c, time.Millisecond); err != nil {
+		t.Fatalf("WaitFor: %v", err)
+	}
+	if c.calls != 3 {
+		t.Errorf("expected 3 checks, got %d", c.calls)
+	}
+
+	boom := errors.New("boom")
+	if err := WaitFor(context.Background(), &countCondition{readyAfter: 99, err: boom}, time.Millisecond); !errors.Is(err, boom) {
+		t.Errorf("expected condition error, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := WaitFor(ctx, &countCondition{readyAfter: 1 << 30}, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timed out waiting for the thing") {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+	if code := errorsx.ExitCodeFromError(err); code != errorsx.ExitTimeout {
+		t.Errorf("expected exit code %d for a timeout, got %d", errorsx.ExitTimeout, code)
+	}
+}